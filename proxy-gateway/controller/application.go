@@ -0,0 +1,479 @@
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"proxy-gateway/pkg/schedule"
+)
+
+// hostRegex - DNS-имя хоста, допускается wildcard в первой метке
+var hostRegex = regexp.MustCompile(`^(\*\.)?[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+
+// validateApplicationDTO проверяет описание приложения
+func validateApplicationDTO(app *schedule.ApplicationDTO) error {
+	if app == nil {
+		return nil
+	}
+
+	switch app.Kind {
+	case "", "Deployment", "StatefulSet":
+	default:
+		return fmt.Errorf("unsupported application kind: %s", app.Kind)
+	}
+
+	volumes, err := validateVolumesDTO(app.Volumes)
+	if err != nil {
+		return err
+	}
+	for _, c := range app.Containers {
+		if err := validateContainerDTO(c, volumes); err != nil {
+			return fmt.Errorf("container %s: %w", c.Name, err)
+		}
+	}
+	for _, c := range app.InitContainers {
+		if c.LivenessProbe != nil || c.ReadinessProbe != nil || c.StartupProbe != nil {
+			return fmt.Errorf("init container %s: probes are not allowed", c.Name)
+		}
+		if err := validateContainerDTO(c, volumes); err != nil {
+			return fmt.Errorf("init container %s: %w", c.Name, err)
+		}
+	}
+
+	if err := validatePlacementDTO(app); err != nil {
+		return err
+	}
+	for _, name := range app.ImagePullSecrets {
+		if !nameRegex.MatchString(name) {
+			return fmt.Errorf("invalid imagePullSecret name: %s", name)
+		}
+	}
+	if app.ServiceAccountName != "" && !nameRegex.MatchString(app.ServiceAccountName) {
+		return fmt.Errorf("invalid serviceAccountName: %s", app.ServiceAccountName)
+	}
+	if app.TerminationGracePeriodSeconds != nil && *app.TerminationGracePeriodSeconds < 0 {
+		return fmt.Errorf("terminationGracePeriodSeconds must not be negative")
+	}
+
+	if app.Service != nil {
+		if err := validateServiceDTO(app.Service); err != nil {
+			return fmt.Errorf("service: %w", err)
+		}
+	}
+	if app.Ingress != nil {
+		if app.Service == nil {
+			return fmt.Errorf("ingress requires service")
+		}
+		if err := validateIngressDTO(app.Ingress); err != nil {
+			return fmt.Errorf("ingress: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateContainerDTO проверяет контейнер; volumes - имена томов пода
+func validateContainerDTO(c schedule.ContainerDTO, volumes map[string]bool) error {
+	switch c.ImagePullPolicy {
+	case "", "Always", "IfNotPresent", "Never":
+	default:
+		return fmt.Errorf("unsupported imagePullPolicy: %s", c.ImagePullPolicy)
+	}
+	if err := validateEnvDTO(c); err != nil {
+		return err
+	}
+	if err := validateProbesDTO(c); err != nil {
+		return err
+	}
+	if r := c.Resources; r != nil {
+		if err := validateQuantitiesDTO("requests", r.Requests); err != nil {
+			return err
+		}
+		if err := validateQuantitiesDTO("limits", r.Limits); err != nil {
+			return err
+		}
+	}
+	if l := c.Lifecycle; l != nil {
+		for name, h := range map[string]*schedule.LifecycleHandlerDTO{"postStart": l.PostStart, "preStop": l.PreStop} {
+			if h != nil && countSet(h.Exec != nil, h.HTTPGet != nil) != 1 {
+				return fmt.Errorf("lifecycle %s: exactly one of exec, httpGet is required", name)
+			}
+		}
+	}
+	for _, m := range c.VolumeMounts {
+		if !volumes[m.Name] {
+			return fmt.Errorf("unknown volume %s", m.Name)
+		}
+		if !strings.HasPrefix(m.MountPath, "/") {
+			return fmt.Errorf("mountPath must be absolute: %s", m.MountPath)
+		}
+	}
+	return nil
+}
+
+// validateQuantitiesDTO проверяет, что cpu и memory - корректные quantity
+// Kubernetes ("500m", "256Mi")
+func validateQuantitiesDTO(name string, q *schedule.ResourceQuantityDTO) error {
+	if q == nil {
+		return nil
+	}
+	for resourceName, value := range map[string]string{"cpu": q.CPU, "memory": q.Memory} {
+		if value == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return fmt.Errorf("invalid %s.%s: %s", name, resourceName, value)
+		}
+	}
+	return nil
+}
+
+// validateEnvDTO проверяет переменные окружения контейнера. Наличие самих
+// Secret и ConfigMap проверяет scale-handler.
+func validateEnvDTO(c schedule.ContainerDTO) error {
+	for _, e := range c.Env {
+		if e.Name == "" {
+			return fmt.Errorf("env name is required")
+		}
+		src := e.ValueFrom
+		if src == nil {
+			continue
+		}
+		if e.Value != "" {
+			return fmt.Errorf("env %s: value and valueFrom are mutually exclusive", e.Name)
+		}
+		sources := 0
+		for _, ref := range []*schedule.KeySelectorDTO{src.SecretKeyRef, src.ConfigMapKeyRef} {
+			if ref == nil {
+				continue
+			}
+			sources++
+			if ref.Name == "" || ref.Key == "" {
+				return fmt.Errorf("env %s: name and key are required", e.Name)
+			}
+		}
+		if src.FieldRef != nil {
+			sources++
+			if src.FieldRef.FieldPath == "" {
+				return fmt.Errorf("env %s: fieldPath is required", e.Name)
+			}
+		}
+		if sources != 1 {
+			return fmt.Errorf("env %s: valueFrom must have exactly one source", e.Name)
+		}
+	}
+	for _, e := range c.EnvFrom {
+		if (e.SecretRef == nil) == (e.ConfigMapRef == nil) {
+			return fmt.Errorf("envFrom must have exactly one of secretRef and configMapRef")
+		}
+		if (e.SecretRef != nil && e.SecretRef.Name == "") || (e.ConfigMapRef != nil && e.ConfigMapRef.Name == "") {
+			return fmt.Errorf("envFrom: name is required")
+		}
+	}
+	return nil
+}
+
+// validateProbesDTO проверяет liveness, readiness и startup проверки
+func validateProbesDTO(c schedule.ContainerDTO) error {
+	probes := []struct {
+		name  string
+		probe *schedule.ProbeDTO
+	}{
+		{"livenessProbe", c.LivenessProbe},
+		{"readinessProbe", c.ReadinessProbe},
+		{"startupProbe", c.StartupProbe},
+	}
+	for _, p := range probes {
+		if p.probe == nil {
+			continue
+		}
+		if err := validateProbeDTO(p.probe, p.name == "readinessProbe"); err != nil {
+			return fmt.Errorf("%s: %w", p.name, err)
+		}
+	}
+	return nil
+}
+
+func validateProbeDTO(p *schedule.ProbeDTO, readiness bool) error {
+	if countSet(p.HTTPGet != nil, p.TCPSocket != nil, p.Exec != nil, p.GRPC != nil) != 1 {
+		return fmt.Errorf("exactly one of httpGet, tcpSocket, exec, grpc is required")
+	}
+	switch {
+	case p.HTTPGet != nil:
+		if !validPort(p.HTTPGet.Port) {
+			return fmt.Errorf("port must be within [1, 65535]: %d", p.HTTPGet.Port)
+		}
+		switch p.HTTPGet.Scheme {
+		case "", "HTTP", "HTTPS":
+		default:
+			return fmt.Errorf("unsupported scheme: %s", p.HTTPGet.Scheme)
+		}
+		for _, h := range p.HTTPGet.HTTPHeaders {
+			if h.Name == "" {
+				return fmt.Errorf("header name is required")
+			}
+		}
+	case p.TCPSocket != nil:
+		if !validPort(p.TCPSocket.Port) {
+			return fmt.Errorf("port must be within [1, 65535]: %d", p.TCPSocket.Port)
+		}
+	case p.Exec != nil:
+		if len(p.Exec.Command) == 0 {
+			return fmt.Errorf("exec command is required")
+		}
+	case p.GRPC != nil:
+		if !validPort(p.GRPC.Port) {
+			return fmt.Errorf("port must be within [1, 65535]: %d", p.GRPC.Port)
+		}
+	}
+
+	for _, v := range []int32{p.InitialDelaySeconds, p.PeriodSeconds, p.TimeoutSeconds, p.FailureThreshold, p.SuccessThreshold} {
+		if v < 0 {
+			return fmt.Errorf("probe settings must not be negative")
+		}
+	}
+	if p.SuccessThreshold > 1 && !readiness {
+		return fmt.Errorf("successThreshold must be 1")
+	}
+	return nil
+}
+
+// validateVolumesDTO проверяет тома и возвращает множество их имён
+func validateVolumesDTO(volumes []schedule.VolumeDTO) (map[string]bool, error) {
+	names := make(map[string]bool, len(volumes))
+	for _, v := range volumes {
+		if v.Name == "" {
+			return nil, fmt.Errorf("volume name is required")
+		}
+		if names[v.Name] {
+			return nil, fmt.Errorf("duplicate volume: %s", v.Name)
+		}
+		names[v.Name] = true
+
+		if countSet(v.EmptyDir != nil, v.ConfigMap != nil, v.Secret != nil, v.PersistentVolumeClaim != nil, v.Projected != nil) != 1 {
+			return nil, fmt.Errorf("volume %s must have exactly one source", v.Name)
+		}
+		switch {
+		case v.EmptyDir != nil:
+			if v.EmptyDir.Medium != "" && v.EmptyDir.Medium != "Memory" {
+				return nil, fmt.Errorf("volume %s: unsupported medium: %s", v.Name, v.EmptyDir.Medium)
+			}
+			if v.EmptyDir.SizeLimit != "" {
+				if _, err := resource.ParseQuantity(v.EmptyDir.SizeLimit); err != nil {
+					return nil, fmt.Errorf("volume %s: invalid sizeLimit: %s", v.Name, v.EmptyDir.SizeLimit)
+				}
+			}
+		case v.ConfigMap != nil && v.ConfigMap.Name == "", v.Secret != nil && v.Secret.Name == "":
+			return nil, fmt.Errorf("volume %s: name is required", v.Name)
+		case v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == "":
+			return nil, fmt.Errorf("volume %s: claimName is required", v.Name)
+		case v.Projected != nil:
+			if len(v.Projected.Sources) == 0 {
+				return nil, fmt.Errorf("volume %s: projected sources are required", v.Name)
+			}
+			for _, s := range v.Projected.Sources {
+				if countSet(s.ConfigMap != nil, s.Secret != nil, s.ServiceAccountToken != nil) != 1 {
+					return nil, fmt.Errorf("volume %s: projected source must have exactly one of configMap, secret, serviceAccountToken", v.Name)
+				}
+				if s.ServiceAccountToken != nil && s.ServiceAccountToken.Path == "" {
+					return nil, fmt.Errorf("volume %s: serviceAccountToken path is required", v.Name)
+				}
+			}
+		}
+	}
+	return names, nil
+}
+
+// validatePlacementDTO проверяет tolerations, affinity и topology spread
+func validatePlacementDTO(app *schedule.ApplicationDTO) error {
+	for _, t := range app.Tolerations {
+		switch t.Operator {
+		case "", "Equal":
+		case "Exists":
+			if t.Value != "" {
+				return fmt.Errorf("toleration %s: value must be empty for operator Exists", t.Key)
+			}
+		default:
+			return fmt.Errorf("toleration %s: unsupported operator: %s", t.Key, t.Operator)
+		}
+		switch t.Effect {
+		case "", "NoSchedule", "PreferNoSchedule", "NoExecute":
+		default:
+			return fmt.Errorf("toleration %s: unsupported effect: %s", t.Key, t.Effect)
+		}
+		if t.TolerationSeconds != nil && t.Effect != "NoExecute" {
+			return fmt.Errorf("toleration %s: tolerationSeconds requires effect NoExecute", t.Key)
+		}
+	}
+
+	if a := app.Affinity; a != nil {
+		if na := a.NodeAffinity; na != nil {
+			for _, term := range na.Required {
+				if err := validateNodeSelectorTermDTO(term); err != nil {
+					return fmt.Errorf("nodeAffinity: %w", err)
+				}
+			}
+			for _, p := range na.Preferred {
+				if !validWeight(p.Weight) {
+					return fmt.Errorf("nodeAffinity: weight must be within [1, 100]: %d", p.Weight)
+				}
+				if err := validateNodeSelectorTermDTO(p.Preference); err != nil {
+					return fmt.Errorf("nodeAffinity: %w", err)
+				}
+			}
+		}
+		if err := validatePodAffinityDTO(a.PodAffinity); err != nil {
+			return fmt.Errorf("podAffinity: %w", err)
+		}
+		if err := validatePodAffinityDTO(a.PodAntiAffinity); err != nil {
+			return fmt.Errorf("podAntiAffinity: %w", err)
+		}
+	}
+
+	for _, c := range app.TopologySpreadConstraints {
+		if c.MaxSkew < 1 {
+			return fmt.Errorf("topologySpreadConstraints: maxSkew must be at least 1")
+		}
+		if c.TopologyKey == "" {
+			return fmt.Errorf("topologySpreadConstraints: topologyKey is required")
+		}
+		switch c.WhenUnsatisfiable {
+		case "", "DoNotSchedule", "ScheduleAnyway":
+		default:
+			return fmt.Errorf("topologySpreadConstraints: unsupported whenUnsatisfiable: %s", c.WhenUnsatisfiable)
+		}
+		if err := validateLabelSelectorDTO(c.LabelSelector); err != nil {
+			return fmt.Errorf("topologySpreadConstraints: %w", err)
+		}
+	}
+	return nil
+}
+
+func validateNodeSelectorTermDTO(term schedule.NodeSelectorTermDTO) error {
+	if len(term.MatchExpressions) == 0 {
+		return fmt.Errorf("matchExpressions are required")
+	}
+	for _, r := range term.MatchExpressions {
+		if err := validateRequirementDTO(r, true); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validatePodAffinityDTO(a *schedule.PodAffinityDTO) error {
+	if a == nil {
+		return nil
+	}
+	terms := append([]schedule.PodAffinityTermDTO(nil), a.Required...)
+	for _, p := range a.Preferred {
+		if !validWeight(p.Weight) {
+			return fmt.Errorf("weight must be within [1, 100]: %d", p.Weight)
+		}
+		terms = append(terms, p.Term)
+	}
+	for _, term := range terms {
+		if term.TopologyKey == "" {
+			return fmt.Errorf("topologyKey is required")
+		}
+		if err := validateLabelSelectorDTO(term.LabelSelector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateLabelSelectorDTO(s *schedule.LabelSelectorDTO) error {
+	if s == nil {
+		return nil
+	}
+	for _, r := range s.MatchExpressions {
+		if err := validateRequirementDTO(r, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRequirementDTO проверяет оператор и значения; Gt и Lt допустимы
+// только для меток узлов
+func validateRequirementDTO(r schedule.SelectorRequirementDTO, node bool) error {
+	if r.Key == "" {
+		return fmt.Errorf("selector key is required")
+	}
+	switch r.Operator {
+	case "In", "NotIn":
+		if len(r.Values) == 0 {
+			return fmt.Errorf("selector %s: values are required for operator %s", r.Key, r.Operator)
+		}
+	case "Exists", "DoesNotExist":
+		if len(r.Values) > 0 {
+			return fmt.Errorf("selector %s: values must be empty for operator %s", r.Key, r.Operator)
+		}
+	case "Gt", "Lt":
+		if !node {
+			return fmt.Errorf("selector %s: unsupported operator: %s", r.Key, r.Operator)
+		}
+		if len(r.Values) != 1 {
+			return fmt.Errorf("selector %s: exactly one value is required for operator %s", r.Key, r.Operator)
+		}
+	default:
+		return fmt.Errorf("selector %s: unsupported operator: %s", r.Key, r.Operator)
+	}
+	return nil
+}
+
+func validWeight(weight int32) bool {
+	return weight >= 1 && weight <= 100
+}
+
+func countSet(flags ...bool) int {
+	n := 0
+	for _, f := range flags {
+		if f {
+			n++
+		}
+	}
+	return n
+}
+
+func validateServiceDTO(s *schedule.ServiceSpecDTO) error {
+	switch s.Type {
+	case "", "ClusterIP", "NodePort", "LoadBalancer":
+	default:
+		return fmt.Errorf("unsupported type: %s", s.Type)
+	}
+	for _, p := range s.Ports {
+		if !validPort(p.Port) || (p.TargetPort != 0 && !validPort(p.TargetPort)) {
+			return fmt.Errorf("port must be within [1, 65535]: %d", p.Port)
+		}
+		if p.Protocol != "" && strings.ToUpper(p.Protocol) != "TCP" && strings.ToUpper(p.Protocol) != "UDP" {
+			return fmt.Errorf("unsupported protocol: %s", p.Protocol)
+		}
+	}
+	return nil
+}
+
+func validateIngressDTO(i *schedule.IngressSpecDTO) error {
+	if !hostRegex.MatchString(i.Host) {
+		return fmt.Errorf("invalid host: %s", i.Host)
+	}
+	if i.Path != "" && !strings.HasPrefix(i.Path, "/") {
+		return fmt.Errorf("path must start with '/': %s", i.Path)
+	}
+	switch i.PathType {
+	case "", "Prefix", "Exact", "ImplementationSpecific":
+	default:
+		return fmt.Errorf("unsupported pathType: %s", i.PathType)
+	}
+	if i.ServicePort != 0 && !validPort(i.ServicePort) {
+		return fmt.Errorf("servicePort must be within [1, 65535]: %d", i.ServicePort)
+	}
+	return nil
+}
+
+func validPort(port int32) bool {
+	return port >= 1 && port <= 65535
+}
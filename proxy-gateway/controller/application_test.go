@@ -0,0 +1,241 @@
+package controller
+
+import (
+	"testing"
+
+	"proxy-gateway/pkg/schedule"
+)
+
+func TestValidateApplicationDTO(t *testing.T) {
+	withResources := func(r *schedule.ResourcesDTO) *schedule.ApplicationDTO {
+		return &schedule.ApplicationDTO{Containers: []schedule.ContainerDTO{{Name: "web", Image: "nginx", Resources: r}}}
+	}
+	withEnv := func(env []schedule.EnvVarDTO, envFrom []schedule.EnvFromSourceDTO) *schedule.ApplicationDTO {
+		return &schedule.ApplicationDTO{Containers: []schedule.ContainerDTO{{Name: "web", Image: "nginx", Env: env, EnvFrom: envFrom}}}
+	}
+	withVolumes := func(volumes []schedule.VolumeDTO, mounts ...schedule.VolumeMountDTO) *schedule.ApplicationDTO {
+		return &schedule.ApplicationDTO{
+			Containers: []schedule.ContainerDTO{{Name: "web", Image: "nginx", VolumeMounts: mounts}},
+			Volumes:    volumes,
+		}
+	}
+	withProbe := func(startup *schedule.ProbeDTO) *schedule.ApplicationDTO {
+		return &schedule.ApplicationDTO{Containers: []schedule.ContainerDTO{{Name: "web", Image: "nginx", StartupProbe: startup}}}
+	}
+	secretKey := &schedule.EnvVarSourceDTO{SecretKeyRef: &schedule.KeySelectorDTO{Name: "db", Key: "password"}}
+
+	tests := []struct {
+		name    string
+		app     *schedule.ApplicationDTO
+		wantErr bool
+	}{
+		{name: "no application"},
+		{
+			name: "valid quantities",
+			app: withResources(&schedule.ResourcesDTO{
+				Requests: &schedule.ResourceQuantityDTO{CPU: "250m", Memory: "128Mi"},
+				Limits:   &schedule.ResourceQuantityDTO{CPU: "1", Memory: "1Gi"},
+			}),
+		},
+		{
+			name:    "invalid cpu request",
+			app:     withResources(&schedule.ResourcesDTO{Requests: &schedule.ResourceQuantityDTO{CPU: "half"}}),
+			wantErr: true,
+		},
+		{
+			name:    "invalid memory limit",
+			app:     withResources(&schedule.ResourcesDTO{Limits: &schedule.ResourceQuantityDTO{Memory: "1 GB"}}),
+			wantErr: true,
+		},
+		{
+			name: "env references",
+			app: withEnv(
+				[]schedule.EnvVarDTO{{Name: "DB_PASSWORD", ValueFrom: secretKey}},
+				[]schedule.EnvFromSourceDTO{{ConfigMapRef: &schedule.LocalObjectRefDTO{Name: "settings"}}},
+			),
+		},
+		{
+			name:    "value and valueFrom",
+			app:     withEnv([]schedule.EnvVarDTO{{Name: "DB_PASSWORD", Value: "x", ValueFrom: secretKey}}, nil),
+			wantErr: true,
+		},
+		{
+			name: "two sources",
+			app: withEnv([]schedule.EnvVarDTO{{Name: "DB_PASSWORD", ValueFrom: &schedule.EnvVarSourceDTO{
+				SecretKeyRef:    &schedule.KeySelectorDTO{Name: "db", Key: "password"},
+				ConfigMapKeyRef: &schedule.KeySelectorDTO{Name: "settings", Key: "password"},
+			}}}, nil),
+			wantErr: true,
+		},
+		{
+			name:    "secret key without key",
+			app:     withEnv([]schedule.EnvVarDTO{{Name: "DB_PASSWORD", ValueFrom: &schedule.EnvVarSourceDTO{SecretKeyRef: &schedule.KeySelectorDTO{Name: "db"}}}}, nil),
+			wantErr: true,
+		},
+		{
+			name:    "envFrom without source",
+			app:     withEnv(nil, []schedule.EnvFromSourceDTO{{Prefix: "APP_"}}),
+			wantErr: true,
+		},
+		{
+			name: "mounted volumes",
+			app: withVolumes(
+				[]schedule.VolumeDTO{
+					{Name: "cache", EmptyDir: &schedule.EmptyDirVolumeDTO{SizeLimit: "1Gi"}},
+					{Name: "config", ConfigMap: &schedule.ObjectVolumeDTO{Name: "settings"}},
+				},
+				schedule.VolumeMountDTO{Name: "cache", MountPath: "/cache"},
+				schedule.VolumeMountDTO{Name: "config", MountPath: "/etc/app", ReadOnly: true},
+			),
+		},
+		{
+			name:    "mount of unknown volume",
+			app:     withVolumes(nil, schedule.VolumeMountDTO{Name: "cache", MountPath: "/cache"}),
+			wantErr: true,
+		},
+		{
+			name: "relative mount path",
+			app: withVolumes([]schedule.VolumeDTO{{Name: "cache", EmptyDir: &schedule.EmptyDirVolumeDTO{}}},
+				schedule.VolumeMountDTO{Name: "cache", MountPath: "cache"}),
+			wantErr: true,
+		},
+		{
+			name: "volume with two sources",
+			app: withVolumes([]schedule.VolumeDTO{{
+				Name:      "config",
+				ConfigMap: &schedule.ObjectVolumeDTO{Name: "settings"},
+				Secret:    &schedule.ObjectVolumeDTO{Name: "db"},
+			}}),
+			wantErr: true,
+		},
+		{
+			name:    "invalid sizeLimit",
+			app:     withVolumes([]schedule.VolumeDTO{{Name: "cache", EmptyDir: &schedule.EmptyDirVolumeDTO{SizeLimit: "big"}}}),
+			wantErr: true,
+		},
+		{
+			name: "placement",
+			app: &schedule.ApplicationDTO{
+				NodeSelector: map[string]string{"pool": "spot"},
+				Tolerations:  []schedule.TolerationDTO{{Key: "spot", Operator: "Exists", Effect: "NoSchedule"}},
+				TopologySpreadConstraints: []schedule.TopologySpreadConstraintDTO{
+					{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone"},
+				},
+			},
+		},
+		{
+			name:    "toleration value with Exists",
+			app:     &schedule.ApplicationDTO{Tolerations: []schedule.TolerationDTO{{Key: "spot", Operator: "Exists", Value: "true"}}},
+			wantErr: true,
+		},
+		{
+			name: "topology spread without maxSkew",
+			app: &schedule.ApplicationDTO{TopologySpreadConstraints: []schedule.TopologySpreadConstraintDTO{
+				{TopologyKey: "topology.kubernetes.io/zone"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "pod affinity weight out of range",
+			app: &schedule.ApplicationDTO{Affinity: &schedule.AffinityDTO{PodAffinity: &schedule.PodAffinityDTO{
+				Preferred: []schedule.WeightedPodAffinityTermDTO{{Weight: 101, Term: schedule.PodAffinityTermDTO{TopologyKey: "kubernetes.io/hostname"}}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "tcp startup probe",
+			app:  withProbe(&schedule.ProbeDTO{TCPSocket: &schedule.TCPSocketActionDTO{Port: 8080}, FailureThreshold: 30}),
+		},
+		{
+			name:    "probe without handler",
+			app:     withProbe(&schedule.ProbeDTO{PeriodSeconds: 10}),
+			wantErr: true,
+		},
+		{
+			name:    "exec probe without command",
+			app:     withProbe(&schedule.ProbeDTO{Exec: &schedule.ExecActionDTO{}}),
+			wantErr: true,
+		},
+		{
+			name:    "startup successThreshold",
+			app:     withProbe(&schedule.ProbeDTO{TCPSocket: &schedule.TCPSocketActionDTO{Port: 8080}, SuccessThreshold: 2}),
+			wantErr: true,
+		},
+		{
+			name: "runtime options",
+			app: &schedule.ApplicationDTO{
+				InitContainers: []schedule.ContainerDTO{{Name: "migrate", Image: "app", Command: []string{"/migrate"}}},
+				Containers: []schedule.ContainerDTO{{
+					Name:            "web",
+					Image:           "nginx",
+					ImagePullPolicy: "IfNotPresent",
+					Lifecycle: &schedule.LifecycleDTO{
+						PreStop: &schedule.LifecycleHandlerDTO{Exec: &schedule.ExecActionDTO{Command: []string{"sleep", "5"}}},
+					},
+				}},
+				ImagePullSecrets:   []string{"registry"},
+				ServiceAccountName: "web",
+			},
+		},
+		{
+			name:    "unsupported imagePullPolicy",
+			app:     &schedule.ApplicationDTO{Containers: []schedule.ContainerDTO{{Name: "web", Image: "nginx", ImagePullPolicy: "Sometimes"}}},
+			wantErr: true,
+		},
+		{
+			name: "init container with probe",
+			app: &schedule.ApplicationDTO{InitContainers: []schedule.ContainerDTO{{
+				Name: "migrate", Image: "app", LivenessProbe: &schedule.ProbeDTO{TCPSocket: &schedule.TCPSocketActionDTO{Port: 8080}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name: "preStop without handler",
+			app: &schedule.ApplicationDTO{Containers: []schedule.ContainerDTO{{
+				Name: "web", Image: "nginx", Lifecycle: &schedule.LifecycleDTO{PreStop: &schedule.LifecycleHandlerDTO{}},
+			}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid serviceAccountName",
+			app:     &schedule.ApplicationDTO{ServiceAccountName: "Web_SA"},
+			wantErr: true,
+		},
+		{
+			name: "service and ingress",
+			app: &schedule.ApplicationDTO{
+				Service: &schedule.ServiceSpecDTO{Ports: []schedule.ServicePortDTO{{Port: 80, TargetPort: 8080}}},
+				Ingress: &schedule.IngressSpecDTO{Host: "web.example.com", Path: "/"},
+			},
+		},
+		{
+			name:    "ingress without service",
+			app:     &schedule.ApplicationDTO{Ingress: &schedule.IngressSpecDTO{Host: "web.example.com"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid ingress host",
+			app:     &schedule.ApplicationDTO{Service: &schedule.ServiceSpecDTO{}, Ingress: &schedule.IngressSpecDTO{Host: "Web_Example"}},
+			wantErr: true,
+		},
+		{
+			name:    "service port out of range",
+			app:     &schedule.ApplicationDTO{Service: &schedule.ServiceSpecDTO{Ports: []schedule.ServicePortDTO{{Port: 70000}}}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported kind",
+			app:     &schedule.ApplicationDTO{Kind: "DaemonSet"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateApplicationDTO(tt.app)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateApplicationDTO() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -9,7 +9,9 @@ import (
 	scalehandlerv1 "proxy-gateway/pkg/api/proto/scale-handler"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 type Controller struct {
@@ -99,3 +101,18 @@ func writeJSON(w http.ResponseWriter, status int, data interface{}) {
 func writeError(w http.ResponseWriter, status int, message string) {
 	writeJSON(w, status, map[string]string{"error": message})
 }
+
+// writeGRPCError отдаёт ошибку scale-handler с подходящим HTTP статусом
+func writeGRPCError(w http.ResponseWriter, err error, message string) {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		writeError(w, http.StatusBadRequest, st.Message())
+	case codes.NotFound:
+		writeError(w, http.StatusNotFound, st.Message())
+	case codes.FailedPrecondition:
+		writeError(w, http.StatusConflict, st.Message())
+	default:
+		writeError(w, http.StatusInternalServerError, message)
+	}
+}
@@ -13,6 +13,13 @@ import (
 	"proxy-gateway/pkg/schedule"
 )
 
+var namespaceRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// nameRegex - имя объекта Kubernetes (DNS-1123 subdomain)
+var nameRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9.]{0,251}[a-z0-9])?$`)
+
+var kindRegex = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+
 type CreateScheduleRequest struct {
 	Schedule    *schedule.ScheduleDTO    `json:"schedule"`
 	Application *schedule.ApplicationDTO `json:"application"`
@@ -24,8 +31,11 @@ type CreateScheduleRequest struct {
 // @Tags         schedules
 // @Accept       json
 // @Produce      json
-// @Param        body  body  CreateScheduleRequest  true  "Schedule and Application"
+// @Param        body    body   CreateScheduleRequest  true   "Schedule and Application"
+// @Param        dryRun  query  bool                   false  "Только проверить через server-side dry-run и вернуть объекты"
+// @Param        format  query  string                 false  "Формат объектов при dryRun: json или yaml"
 // @Success      201   {object}  map[string]string  "id"
+// @Success      200   {object}  ManifestsResponse  "при dryRun"
 // @Failure      400  {object}  map[string]string  "error"
 // @Failure      500  {object}  map[string]string  "error"
 // @Router       /v1/schedules [post]
@@ -62,18 +72,35 @@ func (c *Controller) CreateSchedule(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusBadRequest, fmt.Sprintf("Validation failed: %v", err))
 		return
 	}
+	if err := validateApplicationDTO(scheduleReq.Application); err != nil {
+		c.logger.Error("Application validation failed", "error", err)
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Validation failed: %v", err))
+		return
+	}
+
+	dryRun, format, err := dryRunQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	protoSchedule := schedule.DTOToProto(scheduleReq.Schedule)
 	protoApp := schedule.ApplicationDTOToProto(scheduleReq.Application)
 	req := &scalehandlerv1.CreateRequest{
 		Schedule:    protoSchedule,
 		Application: protoApp,
+		DryRun:      dryRun,
+		Format:      format,
 	}
 
 	resp, err := c.grpcClient.Create(ctx, req)
 	if err != nil {
 		c.logger.Error("gRPC call failed", "error", err)
-		writeError(w, http.StatusInternalServerError, "Failed to create schedule")
+		writeGRPCError(w, err, "Failed to create schedule")
+		return
+	}
+	if dryRun {
+		writeManifests(w, resp.Manifests, format)
 		return
 	}
 
@@ -125,28 +152,40 @@ func (c *Controller) validateScheduleDTO(s *schedule.ScheduleDTO) error {
 	// Формат даты ISO 8601: YYYY-MM-DD
 	dateRegex := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
 
-	// Проверяем weekdays
+	// Namespace должен быть корректным DNS-1123 label
+	if s.Namespace != "" && !namespaceRegex.MatchString(s.Namespace) {
+		return fmt.Errorf("invalid namespace: %s", s.Namespace)
+	}
+
+	// Таймзона должна быть из базы IANA
+	if s.Timezone != "" && !isIANATimezone(s.Timezone) {
+		return fmt.Errorf("invalid timezone: %s", s.Timezone)
+	}
+
+	// Проверяем weekdays. Окно может переходить через полночь ("22:00"-"06:00"),
+	// а "to" может быть "24:00"
 	for day, ranges := range s.Weekdays {
 		for _, tr := range ranges {
-			if !timeRegex.MatchString(tr.From) {
-				return fmt.Errorf("invalid time format for 'from' in %s: %s", day, tr.From)
-			}
-			if !timeRegex.MatchString(tr.To) {
-				return fmt.Errorf("invalid time format for 'to' in %s: %s", day, tr.To)
-			}
-			fromTime, _ := time.Parse("15:04", tr.From)
-			toTime, _ := time.Parse("15:04", tr.To)
-			if !fromTime.Before(toTime) {
-				return fmt.Errorf("'from' time must be before 'to' time: %s - %s", tr.From, tr.To)
+			if err := validateTimeRange(timeRegex, tr.From, tr.To); err != nil {
+				return fmt.Errorf("%s: %w", day, err)
 			}
 		}
 	}
 
-	// Проверяем dates (формат YYYY-MM-DD)
+	// Проверяем dates (формат YYYY-MM-DD). Прошедшие даты проверяет
+	// scale-handler: он знает таймзону расписания и сохранённые даты
 	for date := range s.Dates {
 		if !dateRegex.MatchString(date) {
 			return fmt.Errorf("invalid date format: %s, expected YYYY-MM-DD", date)
 		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return fmt.Errorf("invalid date: %s", date)
+		}
+		for _, tr := range s.Dates[date] {
+			if err := validateTimeRange(timeRegex, tr.From, tr.To); err != nil {
+				return fmt.Errorf("%s: %w", date, err)
+			}
+		}
 	}
 
 	// Проверяем exceptions
@@ -154,48 +193,169 @@ func (c *Controller) validateScheduleDTO(s *schedule.ScheduleDTO) error {
 		if !dateRegex.MatchString(date) {
 			return fmt.Errorf("invalid exception date format: %s, expected YYYY-MM-DD", date)
 		}
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return fmt.Errorf("invalid exception date: %s", date)
+		}
+	}
+
+	protoSchedule := schedule.DTOToProto(s)
+	if err := validateTarget(protoSchedule.Target); err != nil {
+		return err
 	}
+	if err := validateMetadata(protoSchedule.Labels, protoSchedule.Annotations); err != nil {
+		return err
+	}
+	return validateScaling(protoSchedule)
+}
+
+// isIANATimezone сообщает, что name - зона из базы IANA. "Local"
+// time.LoadLocation тоже принимает, но в KEDA это была бы зона пода.
+func isIANATimezone(name string) bool {
+	if name == "Local" {
+		return false
+	}
+	loc, err := time.LoadLocation(name)
+	return err == nil && loc.String() == name
+}
 
+// validateTarget проверяет ссылку на существующий workload
+func validateTarget(target *scalehandlerv1.TargetRef) error {
+	if target == nil {
+		return nil
+	}
+	switch {
+	case target.Kind == "", target.Kind == "Deployment", target.Kind == "StatefulSet":
+	case !kindRegex.MatchString(target.Kind):
+		return fmt.Errorf("invalid target kind: %s", target.Kind)
+	case target.ApiVersion == "":
+		// Для прочих видов (например, Argo Rollout) группу не угадать
+		return fmt.Errorf("apiVersion is required for target kind %s", target.Kind)
+	}
+	if !nameRegex.MatchString(target.Name) {
+		return fmt.Errorf("invalid target name: %s", target.Name)
+	}
+	if target.Namespace != "" && !namespaceRegex.MatchString(target.Namespace) {
+		return fmt.Errorf("invalid target namespace: %s", target.Namespace)
+	}
 	return nil
 }
 
-func (c *Controller) validateSchedule(s *scalehandlerv1.Schedule) error {
-	timeRegex := regexp.MustCompile(`^([01]?[0-9]|2[0-3]):[0-5][0-9]$`)
-	dateRegex := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+// validateScaling проверяет параметры ScaledObject: defaultReplicas <= max,
+// max(min, defaultReplicas) <= replicas окна <= max, и метрические триггеры.
+// defaultReplicas ниже min допустим: scale-handler поднимает minReplicaCount
+// до defaultReplicas.
+func validateScaling(s *scalehandlerv1.Schedule) error {
+	minReplicas, maxReplicas := int32(0), int32(100)
+	opts := s.Scaling
+	if opts != nil {
+		if opts.MinReplicaCount != nil {
+			minReplicas = *opts.MinReplicaCount
+		}
+		if opts.MaxReplicaCount != nil {
+			maxReplicas = *opts.MaxReplicaCount
+		}
+		if minReplicas < 0 {
+			return fmt.Errorf("minReplicaCount must not be negative: %d", minReplicas)
+		}
+		if maxReplicas < 1 || maxReplicas < minReplicas {
+			return fmt.Errorf("maxReplicaCount must be positive and not less than minReplicaCount: %d", maxReplicas)
+		}
+		if opts.CooldownPeriod != nil && *opts.CooldownPeriod < 0 {
+			return fmt.Errorf("cooldownPeriod must not be negative: %d", *opts.CooldownPeriod)
+		}
+		if opts.PollingInterval != nil && *opts.PollingInterval < 1 {
+			return fmt.Errorf("pollingInterval must be positive: %d", *opts.PollingInterval)
+		}
+		if fb := opts.Fallback; fb != nil && (fb.FailureThreshold < 1 || fb.Replicas < 0) {
+			return fmt.Errorf("fallback requires positive failureThreshold and non-negative replicas")
+		}
+		if opts.Behavior != nil {
+			if err := validateScalingRules("scaleUp", opts.Behavior.ScaleUp); err != nil {
+				return err
+			}
+			if err := validateScalingRules("scaleDown", opts.Behavior.ScaleDown); err != nil {
+				return err
+			}
+		}
+	}
+
+	if s.DefaultReplicas < 0 || s.DefaultReplicas > maxReplicas {
+		return fmt.Errorf("defaultReplicas %d out of range [0, %d]", s.DefaultReplicas, maxReplicas)
+	}
+	// Окно не может опустить число реплик ниже defaultReplicas
+	minReplicas = max(minReplicas, s.DefaultReplicas)
 
-	for _, daySchedule := range s.Weekdays {
+	check := func(where string, tr *scalehandlerv1.TimeRange) error {
+		if tr != nil && (tr.Replicas < minReplicas || tr.Replicas > maxReplicas) {
+			return fmt.Errorf("%s %s-%s: replicas %d out of range [%d, %d]", where, tr.From, tr.To, tr.Replicas, minReplicas, maxReplicas)
+		}
+		return nil
+	}
+	for day, daySchedule := range s.Weekdays {
 		if daySchedule == nil {
 			continue
 		}
 		for _, tr := range daySchedule.TimeRanges {
-			if tr == nil {
-				continue
-			}
-			if !timeRegex.MatchString(tr.From) {
-				return fmt.Errorf("invalid time format for 'from': %s", tr.From)
-			}
-			if !timeRegex.MatchString(tr.To) {
-				return fmt.Errorf("invalid time format for 'to': %s", tr.To)
-			}
-			fromTime, _ := time.Parse("15:04", tr.From)
-			toTime, _ := time.Parse("15:04", tr.To)
-			if !fromTime.Before(toTime) {
-				return fmt.Errorf("'from' time must be before 'to' time: %s - %s", tr.From, tr.To)
+			if err := check(day, tr); err != nil {
+				return err
 			}
 		}
 	}
-
-	for date := range s.Dates {
-		if !dateRegex.MatchString(date) {
-			return fmt.Errorf("invalid date format: %s, expected YYYY-MM-DD", date)
+	for date, dateSchedule := range s.Dates {
+		if dateSchedule == nil {
+			continue
+		}
+		for _, tr := range dateSchedule.TimeRanges {
+			if err := check(date, tr); err != nil {
+				return err
+			}
 		}
 	}
+	return validateTriggers(s.Triggers)
+}
 
-	for _, date := range s.Exceptions {
-		if !dateRegex.MatchString(date) {
-			return fmt.Errorf("invalid exception date format: %s, expected YYYY-MM-DD", date)
+func validateScalingRules(name string, rules *scalehandlerv1.ScalingRules) error {
+	if rules == nil {
+		return nil
+	}
+	if rules.StabilizationWindowSeconds != nil && (*rules.StabilizationWindowSeconds < 0 || *rules.StabilizationWindowSeconds > 3600) {
+		return fmt.Errorf("%s: stabilizationWindowSeconds must be within [0, 3600]", name)
+	}
+	switch rules.SelectPolicy {
+	case "", "Max", "Min", "Disabled":
+	default:
+		return fmt.Errorf("%s: invalid selectPolicy: %s", name, rules.SelectPolicy)
+	}
+	for _, p := range rules.Policies {
+		if p == nil {
+			continue
+		}
+		if p.Type != "Pods" && p.Type != "Percent" {
+			return fmt.Errorf("%s: invalid policy type: %s", name, p.Type)
+		}
+		if p.Value < 1 || p.PeriodSeconds < 1 || p.PeriodSeconds > 1800 {
+			return fmt.Errorf("%s: policy value must be positive and periodSeconds within [1, 1800]", name)
 		}
 	}
+	return nil
+}
 
+// validateTimeRange проверяет окно HH:MM-HH:MM. Если "to" не позже "from",
+// окно заканчивается на следующий день; "24:00" означает конец суток.
+func validateTimeRange(timeRegex *regexp.Regexp, from, to string) error {
+	if !timeRegex.MatchString(from) {
+		return fmt.Errorf("invalid time format for 'from': %s", from)
+	}
+	if to == "24:00" {
+		return nil
+	}
+	if !timeRegex.MatchString(to) {
+		return fmt.Errorf("invalid time format for 'to': %s", to)
+	}
+	fromTime, _ := time.Parse("15:04", from)
+	toTime, _ := time.Parse("15:04", to)
+	if fromTime.Equal(toTime) {
+		return fmt.Errorf("'from' and 'to' must differ: %s - %s", from, to)
+	}
 	return nil
 }
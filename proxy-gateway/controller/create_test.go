@@ -0,0 +1,211 @@
+package controller
+
+import (
+	"testing"
+
+	"proxy-gateway/pkg/schedule"
+)
+
+func TestValidateScheduleDTO(t *testing.T) {
+	window := func(from, to string, replicas int32) []schedule.TimeRangeDTO {
+		return []schedule.TimeRangeDTO{{From: from, To: to, Replicas: replicas}}
+	}
+	ptr := func(v int32) *int32 { return &v }
+
+	tests := []struct {
+		name    string
+		dto     schedule.ScheduleDTO
+		wantErr bool
+	}{
+		{
+			name: "valid weekday and date windows",
+			dto: schedule.ScheduleDTO{
+				Weekdays:   map[string][]schedule.TimeRangeDTO{"monday": window("09:00", "18:00", 3)},
+				Dates:      map[string][]schedule.TimeRangeDTO{"2030-12-31": window("10:00", "18:00", 5)},
+				Exceptions: []string{"2030-02-28"},
+				Timezone:   "Europe/Berlin",
+				Namespace:  "team-a",
+			},
+		},
+		{
+			// Прошедшие даты проверяет scale-handler
+			name: "past date is left to scale-handler",
+			dto:  schedule.ScheduleDTO{Dates: map[string][]schedule.TimeRangeDTO{"2020-01-01": window("10:00", "12:00", 1)}},
+		},
+		{
+			name: "overnight window and window until 24:00",
+			dto: schedule.ScheduleDTO{
+				Weekdays: map[string][]schedule.TimeRangeDTO{"friday": window("22:00", "06:00", 2)},
+				Dates:    map[string][]schedule.TimeRangeDTO{"2030-12-31": window("18:00", "24:00", 4)},
+			},
+		},
+		{
+			name:    "invalid time",
+			dto:     schedule.ScheduleDTO{Weekdays: map[string][]schedule.TimeRangeDTO{"monday": window("9:60", "18:00", 1)}},
+			wantErr: true,
+		},
+		{
+			name:    "empty window",
+			dto:     schedule.ScheduleDTO{Weekdays: map[string][]schedule.TimeRangeDTO{"monday": window("10:00", "10:00", 1)}},
+			wantErr: true,
+		},
+		{
+			name:    "nonexistent date",
+			dto:     schedule.ScheduleDTO{Dates: map[string][]schedule.TimeRangeDTO{"2030-02-30": window("10:00", "12:00", 1)}},
+			wantErr: true,
+		},
+		{
+			name:    "nonexistent exception",
+			dto:     schedule.ScheduleDTO{Exceptions: []string{"2030-02-30"}},
+			wantErr: true,
+		},
+		{
+			name:    "malformed exception",
+			dto:     schedule.ScheduleDTO{Exceptions: []string{"30.01.2030"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timezone",
+			dto:     schedule.ScheduleDTO{Timezone: "Mars/Olympus"},
+			wantErr: true,
+		},
+		{
+			// В KEDA "Local" означал бы зону пода
+			name:    "local timezone",
+			dto:     schedule.ScheduleDTO{Timezone: "Local"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid namespace",
+			dto:     schedule.ScheduleDTO{Namespace: "Team_A"},
+			wantErr: true,
+		},
+		{
+			name: "scaling within bounds",
+			dto: schedule.ScheduleDTO{
+				Weekdays: map[string][]schedule.TimeRangeDTO{"monday": window("09:00", "18:00", 3)},
+				Scaling: &schedule.ScalingOptionsDTO{
+					MinReplicaCount: ptr(1),
+					MaxReplicaCount: ptr(5),
+					Behavior: &schedule.ScalingBehaviorDTO{ScaleDown: &schedule.ScalingRulesDTO{
+						SelectPolicy: "Min",
+						Policies:     []schedule.ScalingPolicyDTO{{Type: "Pods", Value: 1, PeriodSeconds: 60}},
+					}},
+				},
+			},
+		},
+		{
+			name: "metric triggers",
+			dto: schedule.ScheduleDTO{
+				Triggers: []schedule.MetricTriggerDTO{
+					{Type: "cpu", MetricType: "Utilization", Metadata: map[string]string{"value": "70"}},
+					{
+						Type:              "rabbitmq",
+						Metadata:          map[string]string{"queueName": "jobs", "value": "20"},
+						AuthenticationRef: &schedule.AuthenticationRefDTO{Name: "rabbitmq-auth"},
+					},
+				},
+			},
+		},
+		{
+			name: "unsupported trigger type",
+			dto: schedule.ScheduleDTO{
+				Triggers: []schedule.MetricTriggerDTO{{Type: "http", Metadata: map[string]string{"value": "1"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "trigger without required metadata",
+			dto: schedule.ScheduleDTO{
+				Triggers: []schedule.MetricTriggerDTO{{Type: "prometheus", Metadata: map[string]string{"query": "up"}}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cpu trigger with authenticationRef",
+			dto: schedule.ScheduleDTO{
+				Triggers: []schedule.MetricTriggerDTO{{
+					Type:              "cpu",
+					MetricType:        "Utilization",
+					Metadata:          map[string]string{"value": "70"},
+					AuthenticationRef: &schedule.AuthenticationRefDTO{Name: "auth"},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "window replicas above maxReplicaCount",
+			dto: schedule.ScheduleDTO{
+				Weekdays: map[string][]schedule.TimeRangeDTO{"monday": window("09:00", "18:00", 3)},
+				Scaling:  &schedule.ScalingOptionsDTO{MaxReplicaCount: ptr(2)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "defaultReplicas below minReplicaCount",
+			dto: schedule.ScheduleDTO{
+				Weekdays:        map[string][]schedule.TimeRangeDTO{"monday": window("09:00", "18:00", 3)},
+				DefaultReplicas: 1,
+				Scaling:         &schedule.ScalingOptionsDTO{MinReplicaCount: ptr(2), MaxReplicaCount: ptr(5)},
+			},
+		},
+		{
+			name: "defaultReplicas above maxReplicaCount",
+			dto: schedule.ScheduleDTO{
+				DefaultReplicas: 6,
+				Scaling:         &schedule.ScalingOptionsDTO{MaxReplicaCount: ptr(5)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "window replicas below defaultReplicas",
+			dto: schedule.ScheduleDTO{
+				Weekdays:        map[string][]schedule.TimeRangeDTO{"monday": window("09:00", "18:00", 1)},
+				DefaultReplicas: 2,
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing workload as target",
+			dto:  schedule.ScheduleDTO{Target: &schedule.TargetRefDTO{Kind: "StatefulSet", Name: "db", Namespace: "team-a"}},
+		},
+		{
+			name: "custom scalable target kind",
+			dto: schedule.ScheduleDTO{Target: &schedule.TargetRefDTO{
+				APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Name: "web",
+			}},
+		},
+		{
+			name:    "custom target kind without apiVersion",
+			dto:     schedule.ScheduleDTO{Target: &schedule.TargetRefDTO{Kind: "Rollout", Name: "web"}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid target name",
+			dto:     schedule.ScheduleDTO{Target: &schedule.TargetRefDTO{Name: "Web_App"}},
+			wantErr: true,
+		},
+		{
+			name:    "maxReplicaCount below minReplicaCount",
+			dto:     schedule.ScheduleDTO{Scaling: &schedule.ScalingOptionsDTO{MinReplicaCount: ptr(5), MaxReplicaCount: ptr(2)}},
+			wantErr: true,
+		},
+		{
+			name: "invalid scaling policy type",
+			dto: schedule.ScheduleDTO{Scaling: &schedule.ScalingOptionsDTO{Behavior: &schedule.ScalingBehaviorDTO{
+				ScaleUp: &schedule.ScalingRulesDTO{Policies: []schedule.ScalingPolicyDTO{{Type: "Nodes", Value: 1, PeriodSeconds: 60}}},
+			}}},
+			wantErr: true,
+		},
+	}
+
+	c := &Controller{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := c.validateScheduleDTO(&tt.dto)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateScheduleDTO() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
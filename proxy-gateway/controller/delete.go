@@ -16,6 +16,7 @@ import (
 // @Param        id   path      string  true  "Schedule UUID"
 // @Success      200  {object}  map[string]bool  "success"
 // @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
 // @Failure      500  {object}  map[string]string  "error"
 // @Router       /v1/schedules/{id} [delete]
 func (c *Controller) DeleteSchedule(w http.ResponseWriter, r *http.Request) {
@@ -40,7 +41,7 @@ func (c *Controller) DeleteSchedule(w http.ResponseWriter, r *http.Request) {
 	resp, err := c.grpcClient.Delete(ctx, req)
 	if err != nil {
 		c.logger.Error("gRPC call failed", "error", err, "id", id)
-		writeError(w, http.StatusInternalServerError, "Failed to delete schedule")
+		writeGRPCError(w, err, "Failed to delete schedule")
 		return
 	}
 
@@ -0,0 +1,41 @@
+package controller
+
+import (
+	"net/http"
+
+	scalehandlerv1 "proxy-gateway/pkg/api/proto/scale-handler"
+	"proxy-gateway/pkg/schedule"
+)
+
+// CollectGarbage godoc
+// @Summary      Сборка осиротевших объектов
+// @Description  Ищет объекты кластера с меткой сервиса, для которых нет расписания. Найденный объект помечается и удаляется при следующем проходе после grace-периода. С dryRun=true объекты только возвращаются в отчёте.
+// @Tags         gc
+// @Produce      json
+// @Param        dryRun  query     bool  false  "Только найти, ничего не менять"
+// @Success      200  {object}  schedule.GCReportDTO
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /v1/gc [post]
+func (c *Controller) CollectGarbage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	c.logger.Info("Handling collect garbage request")
+
+	dryRun, err := queryBool(r, "dryRun")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Вызываем gRPC метод
+	req := &scalehandlerv1.CollectGarbageRequest{DryRun: dryRun}
+	resp, err := c.grpcClient.CollectGarbage(ctx, req)
+	if err != nil {
+		c.logger.Error("gRPC call failed", "error", err)
+		writeGRPCError(w, err, "Failed to collect garbage")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, schedule.GCReportProtoToDTO(resp))
+}
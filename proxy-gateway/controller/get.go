@@ -16,7 +16,7 @@ import (
 // @Tags         schedules
 // @Produce      json
 // @Param        id   path      string  true  "Schedule UUID"
-// @Success      200  {object}  map[string]interface{}  "schedule, application"
+// @Success      200  {object}  map[string]interface{}  "schedule, application, status"
 // @Failure      400  {object}  map[string]string  "error"
 // @Failure      404  {object}  map[string]string  "error"
 // @Router       /v1/schedules/{id} [get]
@@ -51,6 +51,7 @@ func (c *Controller) GetSchedule(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, map[string]interface{}{
 		"schedule":    scheduleDTO,
 		"application": appDTO,
+		"status":      schedule.StatusProtoToDTO(resp.Status),
 	})
 }
 
@@ -33,8 +33,10 @@ func (c *Controller) ListSchedules(w http.ResponseWriter, r *http.Request) {
 		scheduleDTO := schedule.ProtoToDTO(item.Schedule)
 		appDTO := schedule.ProtoToApplicationDTO(item.Application)
 		items[i] = map[string]interface{}{
+			"id":          item.Id,
 			"schedule":    scheduleDTO,
 			"application": appDTO,
+			"status":      schedule.StatusProtoToDTO(item.Status),
 		}
 	}
 
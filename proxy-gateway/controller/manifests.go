@@ -0,0 +1,111 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	scalehandlerv1 "proxy-gateway/pkg/api/proto/scale-handler"
+
+	"github.com/google/uuid"
+)
+
+// ManifestsResponse - объекты Kubernetes расписания в формате json
+type ManifestsResponse struct {
+	Manifests []map[string]interface{} `json:"manifests"`
+}
+
+// GetManifests godoc
+// @Summary      Объекты Kubernetes расписания
+// @Description  Возвращает объекты, которые scale-handler применяет для расписания, не обращаясь к кластеру. С format=yaml ответ - YAML-документы через "---".
+// @Tags         schedules
+// @Produce      json
+// @Produce      application/yaml
+// @Param        id      path      string  true   "Schedule UUID"
+// @Param        format  query     string  false  "json (по умолчанию) или yaml"
+// @Success      200  {object}  ManifestsResponse
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /v1/schedules/{id}/manifests [get]
+func (c *Controller) GetManifests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	c.logger.Info("Handling get manifests request")
+
+	id := extractIDFromPath(r.URL.Path)
+	if _, err := uuid.Parse(id); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid UUID format")
+		return
+	}
+	format, err := manifestFormat(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	// Вызываем gRPC метод
+	req := &scalehandlerv1.RenderRequest{Id: id, Format: format}
+	resp, err := c.grpcClient.Render(ctx, req)
+	if err != nil {
+		c.logger.Error("gRPC call failed", "error", err, "id", id)
+		writeGRPCError(w, err, "Failed to render schedule")
+		return
+	}
+
+	writeManifests(w, resp.Manifests, format)
+}
+
+// writeManifests отдаёт объекты одним YAML-потоком или JSON-массивом
+func writeManifests(w http.ResponseWriter, manifests []*scalehandlerv1.Manifest, format string) {
+	if format == "yaml" {
+		docs := make([]string, len(manifests))
+		for i, m := range manifests {
+			docs[i] = m.Content
+		}
+		w.Header().Set("Content-Type", "application/yaml")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(strings.Join(docs, "---\n")))
+		return
+	}
+
+	items := make([]json.RawMessage, len(manifests))
+	for i, m := range manifests {
+		items[i] = json.RawMessage(m.Content)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"manifests": items,
+	})
+}
+
+// dryRunQuery читает параметры dryRun и format запросов создания и обновления
+func dryRunQuery(r *http.Request) (bool, string, error) {
+	dryRun, err := queryBool(r, "dryRun")
+	if err != nil {
+		return false, "", err
+	}
+	format, err := manifestFormat(r)
+	return dryRun, format, err
+}
+
+func manifestFormat(r *http.Request) (string, error) {
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "json", "yaml":
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid format %s, expected json or yaml", format)
+	}
+}
+
+func queryBool(r *http.Request, name string) (bool, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s value: %s", name, v)
+	}
+	return b, nil
+}
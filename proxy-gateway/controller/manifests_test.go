@@ -0,0 +1,35 @@
+package controller
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDryRunQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		query      string
+		wantDryRun bool
+		wantFormat string
+		wantErr    bool
+	}{
+		{name: "no parameters"},
+		{name: "dry run as yaml", query: "?dryRun=true&format=yaml", wantDryRun: true, wantFormat: "yaml"},
+		{name: "dry run as json", query: "?dryRun=1", wantDryRun: true},
+		{name: "invalid dryRun", query: "?dryRun=yes", wantErr: true},
+		{name: "invalid format", query: "?dryRun=true&format=xml", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("POST", "/v1/schedules"+tt.query, nil)
+			dryRun, format, err := dryRunQuery(r)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("dryRunQuery() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (dryRun != tt.wantDryRun || format != tt.wantFormat) {
+				t.Errorf("dryRunQuery() = %v, %q, want %v, %q", dryRun, format, tt.wantDryRun, tt.wantFormat)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// labelNameRegex - имя метки или аннотации без префикса
+	labelNameRegex = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?$`)
+	// labelPrefixRegex - префикс ключа, DNS-поддомен
+	labelPrefixRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	labelValueRegex  = regexp.MustCompile(`^([A-Za-z0-9]([-A-Za-z0-9_.]{0,61}[A-Za-z0-9])?)?$`)
+)
+
+// reservedLabels выставляет сам scale-handler
+var reservedLabels = map[string]bool{
+	"app":                          true,
+	"app.kubernetes.io/name":       true,
+	"app.kubernetes.io/instance":   true,
+	"app.kubernetes.io/managed-by": true,
+}
+
+// reservedPrefix - префикс служебных аннотаций scale-handler
+const reservedPrefix = "cron-scaler.io/"
+
+// reservedAnnotations управляют паузой KEDA, для неё есть Pause и Resume
+var reservedAnnotations = map[string]bool{
+	"autoscaling.keda.sh/paused":          true,
+	"autoscaling.keda.sh/paused-replicas": true,
+}
+
+// validateMetadata проверяет пользовательские метки и аннотации по правилам
+// Kubernetes и запрещает служебные ключи
+func validateMetadata(labels, annotations map[string]string) error {
+	for key, value := range labels {
+		if err := validateMetadataKey(key); err != nil {
+			return fmt.Errorf("label: %w", err)
+		}
+		if reservedLabels[key] {
+			return fmt.Errorf("label %s is reserved", key)
+		}
+		if !labelValueRegex.MatchString(value) {
+			return fmt.Errorf("invalid label value for %s: %s", key, value)
+		}
+	}
+	for key := range annotations {
+		if err := validateMetadataKey(key); err != nil {
+			return fmt.Errorf("annotation: %w", err)
+		}
+		if reservedAnnotations[key] {
+			return fmt.Errorf("annotation %s is reserved", key)
+		}
+	}
+	return nil
+}
+
+func validateMetadataKey(key string) error {
+	if strings.HasPrefix(key, reservedPrefix) {
+		return fmt.Errorf("key prefix %s is reserved: %s", reservedPrefix, key)
+	}
+	name := key
+	if prefix, rest, ok := strings.Cut(key, "/"); ok {
+		if len(prefix) > 253 || !labelPrefixRegex.MatchString(prefix) {
+			return fmt.Errorf("invalid key prefix: %s", key)
+		}
+		name = rest
+	}
+	if !labelNameRegex.MatchString(name) {
+		return fmt.Errorf("invalid key: %s", key)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package controller
+
+import "testing"
+
+func TestValidateMetadata(t *testing.T) {
+	tests := []struct {
+		name        string
+		labels      map[string]string
+		annotations map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "custom labels and annotations",
+			labels:      map[string]string{"team": "payments", "example.com/tier": "web"},
+			annotations: map[string]string{"example.com/owner": "payments team <payments@example.com>"},
+		},
+		{
+			name:    "reserved label",
+			labels:  map[string]string{"app.kubernetes.io/managed-by": "me"},
+			wantErr: true,
+		},
+		{
+			name:        "reserved annotation prefix",
+			annotations: map[string]string{"cron-scaler.io/revision": "1"},
+			wantErr:     true,
+		},
+		{
+			name:    "invalid label value",
+			labels:  map[string]string{"team": "payments team"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid key prefix",
+			labels:  map[string]string{"Example.com/tier": "web"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateMetadata(tt.labels, tt.annotations)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateMetadata() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
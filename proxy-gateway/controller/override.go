@@ -0,0 +1,178 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	scalehandlerv1 "proxy-gateway/pkg/api/proto/scale-handler"
+	"proxy-gateway/pkg/schedule"
+
+	"github.com/google/uuid"
+)
+
+type CreateOverrideRequest struct {
+	Replicas int32  `json:"replicas"`        // нижняя граница, не ниже minReplicaCount расписания
+	Start    string `json:"start,omitempty"` // RFC 3339, пусто = сейчас
+	End      string `json:"end"`             // RFC 3339
+	Reason   string `json:"reason,omitempty"`
+}
+
+// CreateOverride godoc
+// @Summary      Временно переопределить число реплик
+// @Description  Держит не меньше replicas реплик с start до end поверх окон расписания. Опустить реплики ниже активного окна или minReplicaCount нельзя: действует большее значение, а replicas ниже minReplicaCount отклоняется. После end расписание возвращается к обычному поведению само. Переопределение должно быть короче года.
+// @Tags         overrides
+// @Accept       json
+// @Produce      json
+// @Param        id    path  string                 true  "Schedule UUID"
+// @Param        body  body  CreateOverrideRequest  true  "Override"
+// @Success      201  {object}  schedule.OverrideDTO
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /v1/schedules/{id}/overrides [post]
+func (c *Controller) CreateOverride(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	c.logger.Info("Handling create override request")
+
+	parts := strings.Split(r.URL.Path, "/")
+	scheduleID := parts[3]
+	if _, err := uuid.Parse(scheduleID); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid UUID format")
+		return
+	}
+
+	var body CreateOverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request: %v", err))
+		return
+	}
+	if err := validateOverride(&body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Validation failed: %v", err))
+		return
+	}
+
+	// Вызываем gRPC метод
+	req := &scalehandlerv1.CreateOverrideRequest{
+		ScheduleId: scheduleID,
+		Replicas:   body.Replicas,
+		Start:      body.Start,
+		End:        body.End,
+		Reason:     body.Reason,
+	}
+	resp, err := c.grpcClient.CreateOverride(ctx, req)
+	if err != nil {
+		c.logger.Error("gRPC call failed", "error", err, "schedule_id", scheduleID)
+		writeGRPCError(w, err, "Failed to create override")
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, schedule.OverrideProtoToDTO(resp.Override))
+}
+
+// ListOverrides godoc
+// @Summary      Переопределения расписания
+// @Description  Возвращает все переопределения расписания, включая истёкшие
+// @Tags         overrides
+// @Produce      json
+// @Param        id   path      string  true  "Schedule UUID"
+// @Success      200  {object}  map[string]interface{}  "items"
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /v1/schedules/{id}/overrides [get]
+func (c *Controller) ListOverrides(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	c.logger.Info("Handling list overrides request")
+
+	parts := strings.Split(r.URL.Path, "/")
+	scheduleID := parts[3]
+	if _, err := uuid.Parse(scheduleID); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid UUID format")
+		return
+	}
+
+	// Вызываем gRPC метод
+	req := &scalehandlerv1.ListOverridesRequest{ScheduleId: scheduleID}
+	resp, err := c.grpcClient.ListOverrides(ctx, req)
+	if err != nil {
+		c.logger.Error("gRPC call failed", "error", err, "schedule_id", scheduleID)
+		writeGRPCError(w, err, "Failed to list overrides")
+		return
+	}
+
+	items := make([]*schedule.OverrideDTO, len(resp.Items))
+	for i, item := range resp.Items {
+		items[i] = schedule.OverrideProtoToDTO(item)
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"items": items,
+	})
+}
+
+// CancelOverride godoc
+// @Summary      Отменить переопределение
+// @Description  Удаляет переопределение, расписание сразу возвращается к обычным окнам
+// @Tags         overrides
+// @Produce      json
+// @Param        id          path      string  true  "Schedule UUID"
+// @Param        overrideId  path      string  true  "Override UUID"
+// @Success      200  {object}  map[string]bool  "success"
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /v1/schedules/{id}/overrides/{overrideId} [delete]
+func (c *Controller) CancelOverride(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	c.logger.Info("Handling cancel override request")
+
+	parts := strings.Split(r.URL.Path, "/")
+	scheduleID, id := parts[3], parts[5]
+	if _, err := uuid.Parse(scheduleID); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid UUID format")
+		return
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid override UUID format")
+		return
+	}
+
+	// Вызываем gRPC метод
+	req := &scalehandlerv1.CancelOverrideRequest{ScheduleId: scheduleID, Id: id}
+	resp, err := c.grpcClient.CancelOverride(ctx, req)
+	if err != nil {
+		c.logger.Error("gRPC call failed", "error", err, "schedule_id", scheduleID, "id", id)
+		writeGRPCError(w, err, "Failed to cancel override")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]bool{
+		"success": resp.Success,
+	})
+}
+
+// validateOverride проверяет формат; границы относительно текущего времени
+// и расписания проверяет scale-handler
+func validateOverride(o *CreateOverrideRequest) error {
+	if o.Replicas < 0 {
+		return fmt.Errorf("replicas must not be negative: %d", o.Replicas)
+	}
+	var start time.Time
+	if o.Start != "" {
+		var err error
+		if start, err = time.Parse(time.RFC3339, o.Start); err != nil {
+			return fmt.Errorf("invalid start, expected RFC 3339: %s", o.Start)
+		}
+	}
+	end, err := time.Parse(time.RFC3339, o.End)
+	if err != nil {
+		return fmt.Errorf("invalid end, expected RFC 3339: %s", o.End)
+	}
+	if !start.IsZero() && !end.After(start) {
+		return fmt.Errorf("end must be after start")
+	}
+	return nil
+}
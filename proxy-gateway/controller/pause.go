@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	scalehandlerv1 "proxy-gateway/pkg/api/proto/scale-handler"
+	"proxy-gateway/pkg/schedule"
+
+	"github.com/google/uuid"
+)
+
+type PauseScheduleRequest struct {
+	Replicas *int32 `json:"replicas,omitempty"` // пусто = текущее число реплик
+	PausedBy string `json:"pausedBy"`
+	Reason   string `json:"reason,omitempty"`
+}
+
+// PauseSchedule godoc
+// @Summary      Приостановить расписание
+// @Description  KEDA перестаёт масштабировать workload и держит его на заданном или текущем числе реплик. Повторный вызов заменяет число реплик и причину.
+// @Tags         schedules
+// @Accept       json
+// @Produce      json
+// @Param        id    path  string                true  "Schedule UUID"
+// @Param        body  body  PauseScheduleRequest  true  "Кто и почему приостанавливает"
+// @Success      200  {object}  schedule.ScheduleStatusDTO
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /v1/schedules/{id}:pause [post]
+func (c *Controller) PauseSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	c.logger.Info("Handling pause schedule request")
+
+	id, ok := scheduleActionID(w, r.URL.Path, "pause")
+	if !ok {
+		return
+	}
+
+	var body PauseScheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request: %v", err))
+		return
+	}
+	if strings.TrimSpace(body.PausedBy) == "" {
+		writeError(w, http.StatusBadRequest, "pausedBy is required")
+		return
+	}
+	if body.Replicas != nil && *body.Replicas < 0 {
+		writeError(w, http.StatusBadRequest, "replicas must not be negative")
+		return
+	}
+
+	// Вызываем gRPC метод
+	req := &scalehandlerv1.PauseRequest{
+		Id:       id,
+		Replicas: body.Replicas,
+		PausedBy: body.PausedBy,
+		Reason:   body.Reason,
+	}
+	resp, err := c.grpcClient.Pause(ctx, req)
+	if err != nil {
+		c.logger.Error("gRPC call failed", "error", err, "id", id)
+		writeGRPCError(w, err, "Failed to pause schedule")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, schedule.StatusProtoToDTO(resp.Status))
+}
+
+// ResumeSchedule godoc
+// @Summary      Возобновить расписание
+// @Description  Снимает паузу, KEDA снова масштабирует workload по расписанию
+// @Tags         schedules
+// @Produce      json
+// @Param        id   path      string  true  "Schedule UUID"
+// @Success      200  {object}  schedule.ScheduleStatusDTO
+// @Failure      400  {object}  map[string]string  "error"
+// @Failure      404  {object}  map[string]string  "error"
+// @Failure      409  {object}  map[string]string  "error"
+// @Failure      500  {object}  map[string]string  "error"
+// @Router       /v1/schedules/{id}:resume [post]
+func (c *Controller) ResumeSchedule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	c.logger.Info("Handling resume schedule request")
+
+	id, ok := scheduleActionID(w, r.URL.Path, "resume")
+	if !ok {
+		return
+	}
+
+	// Вызываем gRPC метод
+	req := &scalehandlerv1.ResumeRequest{Id: id}
+	resp, err := c.grpcClient.Resume(ctx, req)
+	if err != nil {
+		c.logger.Error("gRPC call failed", "error", err, "id", id)
+		writeGRPCError(w, err, "Failed to resume schedule")
+		return
+	}
+
+	writeJSON(w, http.StatusOK, schedule.StatusProtoToDTO(resp.Status))
+}
+
+// scheduleActionID извлекает ID из пути /v1/schedules/{id}:action и
+// отвечает 400, если ID некорректен
+func scheduleActionID(w http.ResponseWriter, path, action string) (string, bool) {
+	id := strings.TrimSuffix(extractIDFromPath(path), ":"+action)
+	if _, err := uuid.Parse(id); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid UUID format")
+		return "", false
+	}
+	return id, true
+}
@@ -1,6 +1,9 @@
 package controller
 
-import "net/http"
+import (
+	"net/http"
+	"strings"
+)
 
 type Router struct {
 	controller *Controller
@@ -25,6 +28,27 @@ func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 	case path == "/v1/schedules" && method == "GET":
 		r.controller.ListSchedules(w, req)
 
+	case path == "/v1/gc" && method == "POST":
+		r.controller.CollectGarbage(w, req)
+
+	case isScheduleSubpath(path, "manifests") && method == "GET":
+		r.controller.GetManifests(w, req)
+
+	case isScheduleSubpath(path, "overrides") && method == "POST":
+		r.controller.CreateOverride(w, req)
+
+	case isScheduleSubpath(path, "overrides") && method == "GET":
+		r.controller.ListOverrides(w, req)
+
+	case isOverridePath(path) && method == "DELETE":
+		r.controller.CancelOverride(w, req)
+
+	case isScheduleAction(path, "pause") && method == "POST":
+		r.controller.PauseSchedule(w, req)
+
+	case isScheduleAction(path, "resume") && method == "POST":
+		r.controller.ResumeSchedule(w, req)
+
 	case isScheduleWithID(path) && method == "GET":
 		r.controller.GetSchedule(w, req)
 
@@ -46,3 +70,20 @@ func isScheduleWithID(path string) bool {
 	}
 	return path[:len("/v1/schedules/")] == "/v1/schedules/"
 }
+
+// isScheduleAction проверяет путь вида /v1/schedules/{id}:action
+func isScheduleAction(path, action string) bool {
+	return isScheduleWithID(path) && strings.HasSuffix(path, ":"+action)
+}
+
+// isScheduleSubpath проверяет путь вида /v1/schedules/{id}/{sub}
+func isScheduleSubpath(path, sub string) bool {
+	parts := strings.Split(path, "/")
+	return len(parts) == 5 && isScheduleWithID(path) && parts[4] == sub
+}
+
+// isOverridePath проверяет путь вида /v1/schedules/{id}/overrides/{overrideId}
+func isOverridePath(path string) bool {
+	parts := strings.Split(path, "/")
+	return len(parts) == 6 && isScheduleWithID(path) && parts[4] == "overrides" && parts[5] != ""
+}
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"fmt"
+
+	scalehandlerv1 "proxy-gateway/pkg/api/proto/scale-handler"
+)
+
+// triggerMetadata - поддерживаемые типы триггеров KEDA и обязательные ключи
+// их metadata
+var triggerMetadata = map[string][]string{
+	"cpu":           {"value"},
+	"memory":        {"value"},
+	"prometheus":    {"serverAddress", "query", "threshold"},
+	"rabbitmq":      {"queueName", "value"},
+	"kafka":         {"bootstrapServers", "consumerGroup", "lagThreshold"},
+	"aws-sqs-queue": {"queueURL", "queueLength"},
+	"redis":         {"listName", "listLength"},
+}
+
+// validateTriggers проверяет метрические триггеры
+func validateTriggers(triggers []*scalehandlerv1.MetricTrigger) error {
+	names := map[string]bool{}
+	for i, t := range triggers {
+		if t == nil {
+			continue
+		}
+		required, ok := triggerMetadata[t.Type]
+		if !ok {
+			return fmt.Errorf("triggers[%d]: unsupported type: %s", i, t.Type)
+		}
+		if t.Name != "" {
+			if names[t.Name] {
+				return fmt.Errorf("triggers[%d]: duplicate name: %s", i, t.Name)
+			}
+			names[t.Name] = true
+		}
+		for _, key := range required {
+			if t.Metadata[key] == "" {
+				return fmt.Errorf("triggers[%d]: %s trigger requires metadata.%s", i, t.Type, key)
+			}
+		}
+
+		// Ресурсные метрики считает сам HPA, учётные данные им не нужны
+		resource := t.Type == "cpu" || t.Type == "memory"
+		switch {
+		case resource && t.MetricType != "Utilization" && t.MetricType != "AverageValue":
+			return fmt.Errorf("triggers[%d]: %s trigger requires metricType Utilization or AverageValue", i, t.Type)
+		case !resource && t.MetricType != "" && t.MetricType != "AverageValue" && t.MetricType != "Value":
+			return fmt.Errorf("triggers[%d]: invalid metricType: %s", i, t.MetricType)
+		}
+
+		if ref := t.AuthenticationRef; ref != nil {
+			if resource {
+				return fmt.Errorf("triggers[%d]: %s trigger does not support authenticationRef", i, t.Type)
+			}
+			if !nameRegex.MatchString(ref.Name) {
+				return fmt.Errorf("triggers[%d]: invalid authenticationRef name: %s", i, ref.Name)
+			}
+			if ref.Kind != "" && ref.Kind != "TriggerAuthentication" && ref.Kind != "ClusterTriggerAuthentication" {
+				return fmt.Errorf("triggers[%d]: invalid authenticationRef kind: %s", i, ref.Kind)
+			}
+		}
+	}
+	return nil
+}
@@ -24,9 +24,12 @@ type UpdateScheduleRequest struct {
 // @Accept       json
 // @Produce      json
 // @Param        id    path      string  true  "Schedule UUID"
-// @Param        body  body      UpdateScheduleRequest  true  "Schedule and Application"
+// @Param        body    body      UpdateScheduleRequest  true   "Schedule and Application"
+// @Param        dryRun  query     bool                   false  "Только проверить через server-side dry-run и вернуть объекты"
+// @Param        format  query     string                 false  "Формат объектов при dryRun: json или yaml"
 // @Success      200   {object}  map[string]bool  "success"
 // @Failure      400   {object}  map[string]string  "error"
+// @Failure      404   {object}  map[string]string  "error"
 // @Failure      500   {object}  map[string]string  "error"
 // @Router       /v1/schedules/{id} [put]
 func (c *Controller) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
@@ -72,6 +75,17 @@ func (c *Controller) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
 		writeError(w, http.StatusBadRequest, fmt.Sprintf("Validation failed: %v", err))
 		return
 	}
+	if err := validateApplicationDTO(req.Application); err != nil {
+		c.logger.Error("Application validation failed", "error", err)
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Validation failed: %v", err))
+		return
+	}
+
+	dryRun, format, err := dryRunQuery(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
 
 	protoSchedule := schedule.DTOToProto(req.Schedule)
 	protoApp := schedule.ApplicationDTOToProto(req.Application)
@@ -79,12 +93,18 @@ func (c *Controller) UpdateSchedule(w http.ResponseWriter, r *http.Request) {
 		Id:          id,
 		Schedule:    protoSchedule,
 		Application: protoApp,
+		DryRun:      dryRun,
+		Format:      format,
 	}
 
 	resp, err := c.grpcClient.Update(ctx, grpcReq)
 	if err != nil {
 		c.logger.Error("gRPC call failed", "error", err, "id", id)
-		writeError(w, http.StatusInternalServerError, "Failed to update schedule")
+		writeGRPCError(w, err, "Failed to update schedule")
+		return
+	}
+	if dryRun {
+		writeManifests(w, resp.Manifests, format)
 		return
 	}
 
@@ -15,6 +15,61 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/v1/gc": {
+            "post": {
+                "description": "Ищет объекты кластера с меткой сервиса, для которых нет расписания. Найденный объект помечается и удаляется при следующем проходе после grace-периода. С dryRun=true объекты только возвращаются в отчёте.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "gc"
+                ],
+                "summary": "Сборка осиротевших объектов",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Только найти, ничего не менять",
+                        "name": "dryRun",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule.GCReportDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
         "/v1/schedules": {
             "get": {
                 "description": "Возвращает все расписания",
@@ -65,9 +120,27 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controller.CreateScheduleRequest"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Только проверить через server-side dry-run и вернуть объекты",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Формат объектов при dryRun: json или yaml",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
+                    "200": {
+                        "description": "при dryRun",
+                        "schema": {
+                            "$ref": "#/definitions/controller.ManifestsResponse"
+                        }
+                    },
                     "201": {
                         "description": "id",
                         "schema": {
@@ -119,7 +192,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "schedule, application",
+                        "description": "schedule, application, status",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
@@ -173,6 +246,18 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/controller.UpdateScheduleRequest"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Только проверить через server-side dry-run и вернуть объекты",
+                        "name": "dryRun",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Формат объектов при dryRun: json или yaml",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -194,6 +279,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -242,6 +336,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "error",
                         "schema": {
@@ -253,119 +356,1183 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "controller.CreateScheduleRequest": {
-            "type": "object",
-            "properties": {
-                "application": {
-                    "$ref": "#/definitions/schedule.ApplicationDTO"
-                },
-                "schedule": {
-                    "$ref": "#/definitions/schedule.ScheduleDTO"
-                }
-            }
-        },
-        "controller.UpdateScheduleRequest": {
-            "type": "object",
-            "properties": {
-                "application": {
-                    "$ref": "#/definitions/schedule.ApplicationDTO"
-                },
-                "schedule": {
-                    "$ref": "#/definitions/schedule.ScheduleDTO"
-                }
-            }
         },
-        "schedule.ApplicationDTO": {
-            "type": "object",
-            "properties": {
-                "containers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule.ContainerDTO"
+        "/v1/schedules/{id}/manifests": {
+            "get": {
+                "description": "Возвращает объекты, которые scale-handler применяет для расписания, не обращаясь к кластеру. С format=yaml ответ - YAML-документы через \"---\".",
+                "produces": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "schedules"
+                ],
+                "summary": "Объекты Kubernetes расписания",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "json (по умолчанию) или yaml",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/controller.ManifestsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "schedule.ContainerDTO": {
-            "type": "object",
-            "properties": {
-                "env": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule.EnvVarDTO"
+        "/v1/schedules/{id}/overrides": {
+            "get": {
+                "description": "Возвращает все переопределения расписания, включая истёкшие",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "overrides"
+                ],
+                "summary": "Переопределения расписания",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
-                },
-                "image": {
-                    "type": "string"
-                },
-                "livenessProbe": {
-                    "$ref": "#/definitions/schedule.ProbeDTO"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "ports": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schedule.ContainerPortDTO"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "items",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
-                },
-                "readinessProbe": {
-                    "$ref": "#/definitions/schedule.ProbeDTO"
-                },
-                "resources": {
-                    "$ref": "#/definitions/schedule.ResourcesDTO"
-                }
-            }
-        },
-        "schedule.ContainerPortDTO": {
-            "type": "object",
-            "properties": {
-                "containerPort": {
-                    "type": "integer"
-                },
-                "protocol": {
-                    "description": "TCP, UDP",
-                    "type": "string"
-                }
-            }
-        },
-        "schedule.EnvVarDTO": {
-            "type": "object",
-            "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "value": {
-                    "type": "string"
                 }
-            }
-        },
+            },
+            "post": {
+                "description": "Держит не меньше replicas реплик с start до end поверх окон расписания. Опустить реплики ниже активного окна или minReplicaCount нельзя: действует большее значение, а replicas ниже minReplicaCount отклоняется. После end расписание возвращается к обычному поведению само. Переопределение должно быть короче года.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "overrides"
+                ],
+                "summary": "Временно переопределить число реплик",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Override",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.CreateOverrideRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/schedule.OverrideDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/schedules/{id}/overrides/{overrideId}": {
+            "delete": {
+                "description": "Удаляет переопределение, расписание сразу возвращается к обычным окнам",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "overrides"
+                ],
+                "summary": "Отменить переопределение",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Override UUID",
+                        "name": "overrideId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "success",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "boolean"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/schedules/{id}:pause": {
+            "post": {
+                "description": "KEDA перестаёт масштабировать workload и держит его на заданном или текущем числе реплик. Повторный вызов заменяет число реплик и причину.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules"
+                ],
+                "summary": "Приостановить расписание",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Кто и почему приостанавливает",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/controller.PauseScheduleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule.ScheduleStatusDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/schedules/{id}:resume": {
+            "post": {
+                "description": "Снимает паузу, KEDA снова масштабирует workload по расписанию",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "schedules"
+                ],
+                "summary": "Возобновить расписание",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Schedule UUID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/schedule.ScheduleStatusDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "controller.CreateOverrideRequest": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "description": "RFC 3339",
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "replicas": {
+                    "description": "нижняя граница, не ниже minReplicaCount расписания",
+                    "type": "integer"
+                },
+                "start": {
+                    "description": "RFC 3339, пусто = сейчас",
+                    "type": "string"
+                }
+            }
+        },
+        "controller.CreateScheduleRequest": {
+            "type": "object",
+            "properties": {
+                "application": {
+                    "$ref": "#/definitions/schedule.ApplicationDTO"
+                },
+                "schedule": {
+                    "$ref": "#/definitions/schedule.ScheduleDTO"
+                }
+            }
+        },
+        "controller.ManifestsResponse": {
+            "type": "object",
+            "properties": {
+                "manifests": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": true
+                    }
+                }
+            }
+        },
+        "controller.PauseScheduleRequest": {
+            "type": "object",
+            "properties": {
+                "pausedBy": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "replicas": {
+                    "description": "пусто = текущее число реплик",
+                    "type": "integer"
+                }
+            }
+        },
+        "controller.UpdateScheduleRequest": {
+            "type": "object",
+            "properties": {
+                "application": {
+                    "$ref": "#/definitions/schedule.ApplicationDTO"
+                },
+                "schedule": {
+                    "$ref": "#/definitions/schedule.ScheduleDTO"
+                }
+            }
+        },
+        "schedule.AffinityDTO": {
+            "type": "object",
+            "properties": {
+                "nodeAffinity": {
+                    "$ref": "#/definitions/schedule.NodeAffinityDTO"
+                },
+                "podAffinity": {
+                    "$ref": "#/definitions/schedule.PodAffinityDTO"
+                },
+                "podAntiAffinity": {
+                    "$ref": "#/definitions/schedule.PodAffinityDTO"
+                }
+            }
+        },
+        "schedule.ApplicationDTO": {
+            "type": "object",
+            "properties": {
+                "affinity": {
+                    "$ref": "#/definitions/schedule.AffinityDTO"
+                },
+                "containers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.ContainerDTO"
+                    }
+                },
+                "imagePullSecrets": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "ingress": {
+                    "description": "требует service",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schedule.IngressSpecDTO"
+                        }
+                    ]
+                },
+                "initContainers": {
+                    "description": "Параметры пода",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.ContainerDTO"
+                    }
+                },
+                "kind": {
+                    "description": "Deployment (по умолчанию), StatefulSet",
+                    "type": "string"
+                },
+                "nodeSelector": {
+                    "description": "Размещение подов",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "priorityClassName": {
+                    "type": "string"
+                },
+                "securityContext": {
+                    "$ref": "#/definitions/schedule.PodSecurityContextDTO"
+                },
+                "service": {
+                    "$ref": "#/definitions/schedule.ServiceSpecDTO"
+                },
+                "serviceAccountName": {
+                    "type": "string"
+                },
+                "terminationGracePeriodSeconds": {
+                    "type": "integer"
+                },
+                "tolerations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.TolerationDTO"
+                    }
+                },
+                "topologySpreadConstraints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.TopologySpreadConstraintDTO"
+                    }
+                },
+                "volumes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.VolumeDTO"
+                    }
+                }
+            }
+        },
+        "schedule.AuthenticationRefDTO": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "description": "TriggerAuthentication (по умолчанию), ClusterTriggerAuthentication",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.CapabilitiesDTO": {
+            "type": "object",
+            "properties": {
+                "add": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "drop": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "schedule.ContainerDTO": {
+            "type": "object",
+            "properties": {
+                "args": {
+                    "description": "заменяет CMD образа",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "command": {
+                    "description": "заменяет ENTRYPOINT образа",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "env": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.EnvVarDTO"
+                    }
+                },
+                "envFrom": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.EnvFromSourceDTO"
+                    }
+                },
+                "image": {
+                    "type": "string"
+                },
+                "imagePullPolicy": {
+                    "description": "Always, IfNotPresent, Never",
+                    "type": "string"
+                },
+                "lifecycle": {
+                    "$ref": "#/definitions/schedule.LifecycleDTO"
+                },
+                "livenessProbe": {
+                    "$ref": "#/definitions/schedule.ProbeDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.ContainerPortDTO"
+                    }
+                },
+                "readinessProbe": {
+                    "$ref": "#/definitions/schedule.ProbeDTO"
+                },
+                "resources": {
+                    "$ref": "#/definitions/schedule.ResourcesDTO"
+                },
+                "securityContext": {
+                    "$ref": "#/definitions/schedule.SecurityContextDTO"
+                },
+                "startupProbe": {
+                    "$ref": "#/definitions/schedule.ProbeDTO"
+                },
+                "volumeMounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.VolumeMountDTO"
+                    }
+                }
+            }
+        },
+        "schedule.ContainerPortDTO": {
+            "type": "object",
+            "properties": {
+                "containerPort": {
+                    "type": "integer"
+                },
+                "protocol": {
+                    "description": "TCP, UDP",
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.EmptyDirVolumeDTO": {
+            "type": "object",
+            "properties": {
+                "medium": {
+                    "description": "\"\" или Memory",
+                    "type": "string"
+                },
+                "sizeLimit": {
+                    "description": "например 1Gi",
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.EnvFromSourceDTO": {
+            "type": "object",
+            "properties": {
+                "configMapRef": {
+                    "$ref": "#/definitions/schedule.LocalObjectRefDTO"
+                },
+                "prefix": {
+                    "type": "string"
+                },
+                "secretRef": {
+                    "$ref": "#/definitions/schedule.LocalObjectRefDTO"
+                }
+            }
+        },
+        "schedule.EnvVarDTO": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                },
+                "valueFrom": {
+                    "$ref": "#/definitions/schedule.EnvVarSourceDTO"
+                }
+            }
+        },
+        "schedule.EnvVarSourceDTO": {
+            "type": "object",
+            "properties": {
+                "configMapKeyRef": {
+                    "$ref": "#/definitions/schedule.KeySelectorDTO"
+                },
+                "fieldRef": {
+                    "$ref": "#/definitions/schedule.FieldRefDTO"
+                },
+                "secretKeyRef": {
+                    "$ref": "#/definitions/schedule.KeySelectorDTO"
+                }
+            }
+        },
+        "schedule.ExecActionDTO": {
+            "type": "object",
+            "properties": {
+                "command": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "schedule.FallbackDTO": {
+            "type": "object",
+            "properties": {
+                "failureThreshold": {
+                    "type": "integer"
+                },
+                "replicas": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule.FieldRefDTO": {
+            "type": "object",
+            "properties": {
+                "fieldPath": {
+                    "description": "например metadata.name, status.podIP",
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.GCReportDTO": {
+            "type": "object",
+            "properties": {
+                "checked": {
+                    "type": "integer"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "orphans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.OrphanedResourceDTO"
+                    }
+                }
+            }
+        },
+        "schedule.GRPCActionDTO": {
+            "type": "object",
+            "properties": {
+                "port": {
+                    "type": "integer"
+                },
+                "service": {
+                    "type": "string"
+                }
+            }
+        },
         "schedule.HTTPGetActionDTO": {
             "type": "object",
             "properties": {
+                "httpHeaders": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.HTTPHeaderDTO"
+                    }
+                },
+                "path": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "scheme": {
+                    "description": "HTTP (по умолчанию) или HTTPS",
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.HTTPHeaderDTO": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.IngressSpecDTO": {
+            "type": "object",
+            "properties": {
+                "className": {
+                    "type": "string"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "path": {
+                    "description": "по умолчанию \"/\"",
+                    "type": "string"
+                },
+                "pathType": {
+                    "description": "Prefix (по умолчанию), Exact, ImplementationSpecific",
+                    "type": "string"
+                },
+                "servicePort": {
+                    "description": "по умолчанию первый порт Service",
+                    "type": "integer"
+                },
+                "tlsSecretName": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.KeySelectorDTO": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "schedule.KeyToPathDTO": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "mode": {
+                    "type": "integer"
+                },
                 "path": {
                     "type": "string"
+                }
+            }
+        },
+        "schedule.LabelSelectorDTO": {
+            "type": "object",
+            "properties": {
+                "matchExpressions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.SelectorRequirementDTO"
+                    }
+                },
+                "matchLabels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "schedule.LifecycleDTO": {
+            "type": "object",
+            "properties": {
+                "postStart": {
+                    "$ref": "#/definitions/schedule.LifecycleHandlerDTO"
+                },
+                "preStop": {
+                    "$ref": "#/definitions/schedule.LifecycleHandlerDTO"
+                }
+            }
+        },
+        "schedule.LifecycleHandlerDTO": {
+            "type": "object",
+            "properties": {
+                "exec": {
+                    "$ref": "#/definitions/schedule.ExecActionDTO"
+                },
+                "httpGet": {
+                    "$ref": "#/definitions/schedule.HTTPGetActionDTO"
+                }
+            }
+        },
+        "schedule.LocalObjectRefDTO": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "schedule.MetricTriggerDTO": {
+            "type": "object",
+            "properties": {
+                "authenticationRef": {
+                    "$ref": "#/definitions/schedule.AuthenticationRefDTO"
+                },
+                "metadata": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "metricType": {
+                    "description": "Utilization, AverageValue, Value",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "cpu, memory, prometheus, rabbitmq, kafka, aws-sqs-queue, redis",
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.NodeAffinityDTO": {
+            "type": "object",
+            "properties": {
+                "preferred": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.PreferredSchedulingTermDTO"
+                    }
+                },
+                "required": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.NodeSelectorTermDTO"
+                    }
+                }
+            }
+        },
+        "schedule.NodeSelectorTermDTO": {
+            "type": "object",
+            "properties": {
+                "matchExpressions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.SelectorRequirementDTO"
+                    }
+                }
+            }
+        },
+        "schedule.ObjectVolumeDTO": {
+            "type": "object",
+            "properties": {
+                "defaultMode": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.KeyToPathDTO"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "optional": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "schedule.OrphanedResourceDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "marked, deleted",
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                },
+                "orphanedAt": {
+                    "description": "RFC 3339, пусто = ещё не помечен",
+                    "type": "string"
+                },
+                "scheduleId": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.OverrideDTO": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "end": {
+                    "description": "RFC 3339",
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "replicas": {
+                    "type": "integer"
+                },
+                "start": {
+                    "description": "RFC 3339",
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.PauseStateDTO": {
+            "type": "object",
+            "properties": {
+                "pausedAt": {
+                    "description": "RFC 3339",
+                    "type": "string"
+                },
+                "pausedBy": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "replicas": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule.PersistentVolumeClaimDTO": {
+            "type": "object",
+            "properties": {
+                "claimName": {
+                    "type": "string"
+                },
+                "readOnly": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "schedule.PodAffinityDTO": {
+            "type": "object",
+            "properties": {
+                "preferred": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.WeightedPodAffinityTermDTO"
+                    }
+                },
+                "required": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.PodAffinityTermDTO"
+                    }
+                }
+            }
+        },
+        "schedule.PodAffinityTermDTO": {
+            "type": "object",
+            "properties": {
+                "labelSelector": {
+                    "$ref": "#/definitions/schedule.LabelSelectorDTO"
+                },
+                "namespaces": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "topologyKey": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.PodSecurityContextDTO": {
+            "type": "object",
+            "properties": {
+                "fsGroup": {
+                    "type": "integer"
+                },
+                "runAsGroup": {
+                    "type": "integer"
+                },
+                "runAsNonRoot": {
+                    "type": "boolean"
+                },
+                "runAsUser": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule.PreferredSchedulingTermDTO": {
+            "type": "object",
+            "properties": {
+                "preference": {
+                    "$ref": "#/definitions/schedule.NodeSelectorTermDTO"
+                },
+                "weight": {
+                    "description": "1-100",
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule.ProbeDTO": {
+            "type": "object",
+            "properties": {
+                "exec": {
+                    "$ref": "#/definitions/schedule.ExecActionDTO"
+                },
+                "failureThreshold": {
+                    "type": "integer"
+                },
+                "grpc": {
+                    "$ref": "#/definitions/schedule.GRPCActionDTO"
+                },
+                "httpGet": {
+                    "$ref": "#/definitions/schedule.HTTPGetActionDTO"
+                },
+                "initialDelaySeconds": {
+                    "type": "integer"
                 },
-                "port": {
+                "periodSeconds": {
+                    "type": "integer"
+                },
+                "successThreshold": {
+                    "description": "для liveness и startup только 1",
+                    "type": "integer"
+                },
+                "tcpSocket": {
+                    "$ref": "#/definitions/schedule.TCPSocketActionDTO"
+                },
+                "timeoutSeconds": {
                     "type": "integer"
                 }
             }
         },
-        "schedule.ProbeDTO": {
+        "schedule.ProjectedVolumeDTO": {
             "type": "object",
             "properties": {
-                "httpGet": {
-                    "$ref": "#/definitions/schedule.HTTPGetActionDTO"
-                },
-                "initialDelaySeconds": {
+                "defaultMode": {
                     "type": "integer"
                 },
-                "periodSeconds": {
-                    "type": "integer"
+                "sources": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.VolumeProjectionDTO"
+                    }
                 }
             }
         },
@@ -391,9 +1558,86 @@ const docTemplate = `{
                 }
             }
         },
+        "schedule.ScalingBehaviorDTO": {
+            "type": "object",
+            "properties": {
+                "scaleDown": {
+                    "$ref": "#/definitions/schedule.ScalingRulesDTO"
+                },
+                "scaleUp": {
+                    "$ref": "#/definitions/schedule.ScalingRulesDTO"
+                }
+            }
+        },
+        "schedule.ScalingOptionsDTO": {
+            "type": "object",
+            "properties": {
+                "behavior": {
+                    "$ref": "#/definitions/schedule.ScalingBehaviorDTO"
+                },
+                "cooldownPeriod": {
+                    "description": "по умолчанию 300",
+                    "type": "integer"
+                },
+                "fallback": {
+                    "$ref": "#/definitions/schedule.FallbackDTO"
+                },
+                "maxReplicaCount": {
+                    "description": "по умолчанию 100",
+                    "type": "integer"
+                },
+                "minReplicaCount": {
+                    "description": "по умолчанию 0",
+                    "type": "integer"
+                },
+                "pollingInterval": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule.ScalingPolicyDTO": {
+            "type": "object",
+            "properties": {
+                "periodSeconds": {
+                    "type": "integer"
+                },
+                "type": {
+                    "description": "Pods, Percent",
+                    "type": "string"
+                },
+                "value": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule.ScalingRulesDTO": {
+            "type": "object",
+            "properties": {
+                "policies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.ScalingPolicyDTO"
+                    }
+                },
+                "selectPolicy": {
+                    "description": "Max, Min, Disabled",
+                    "type": "string"
+                },
+                "stabilizationWindowSeconds": {
+                    "type": "integer"
+                }
+            }
+        },
         "schedule.ScheduleDTO": {
             "type": "object",
             "properties": {
+                "annotations": {
+                    "description": "добавляются ко всем создаваемым объектам",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
                 "dates": {
                     "type": "object",
                     "additionalProperties": {
@@ -403,12 +1647,49 @@ const docTemplate = `{
                         }
                     }
                 },
+                "defaultReplicas": {
+                    "description": "реплик вне окон, по умолчанию 0",
+                    "type": "integer"
+                },
                 "exceptions": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "labels": {
+                    "description": "добавляются ко всем создаваемым объектам",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "namespace": {
+                    "description": "пусто = namespace по умолчанию",
+                    "type": "string"
+                },
+                "scaling": {
+                    "$ref": "#/definitions/schedule.ScalingOptionsDTO"
+                },
+                "target": {
+                    "description": "существующий workload вместо application",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schedule.TargetRefDTO"
+                        }
+                    ]
+                },
+                "timezone": {
+                    "description": "IANA, например Europe/Berlin",
+                    "type": "string"
+                },
+                "triggers": {
+                    "description": "масштабируют выше окон расписания",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.MetricTriggerDTO"
+                    }
+                },
                 "weekdays": {
                     "type": "object",
                     "additionalProperties": {
@@ -420,6 +1701,165 @@ const docTemplate = `{
                 }
             }
         },
+        "schedule.ScheduleStatusDTO": {
+            "type": "object",
+            "properties": {
+                "appliedGeneration": {
+                    "type": "integer"
+                },
+                "defaultReplicas": {
+                    "description": "реплик вне окон",
+                    "type": "integer"
+                },
+                "generation": {
+                    "type": "integer"
+                },
+                "lastAppliedAt": {
+                    "type": "string"
+                },
+                "lastError": {
+                    "type": "string"
+                },
+                "maxReplicaCount": {
+                    "type": "integer"
+                },
+                "minReplicaCount": {
+                    "description": "действующий, не ниже defaultReplicas",
+                    "type": "integer"
+                },
+                "pause": {
+                    "description": "пусто = расписание активно",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schedule.PauseStateDTO"
+                        }
+                    ]
+                },
+                "phase": {
+                    "description": "Pending, Applied, Degraded",
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.SecurityContextDTO": {
+            "type": "object",
+            "properties": {
+                "allowPrivilegeEscalation": {
+                    "type": "boolean"
+                },
+                "capabilities": {
+                    "$ref": "#/definitions/schedule.CapabilitiesDTO"
+                },
+                "privileged": {
+                    "type": "boolean"
+                },
+                "readOnlyRootFilesystem": {
+                    "type": "boolean"
+                },
+                "runAsGroup": {
+                    "type": "integer"
+                },
+                "runAsNonRoot": {
+                    "type": "boolean"
+                },
+                "runAsUser": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule.SelectorRequirementDTO": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "operator": {
+                    "description": "In, NotIn, Exists, DoesNotExist, а для узлов ещё Gt, Lt",
+                    "type": "string"
+                },
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "schedule.ServiceAccountTokenProjectionDTO": {
+            "type": "object",
+            "properties": {
+                "audience": {
+                    "type": "string"
+                },
+                "expirationSeconds": {
+                    "type": "integer"
+                },
+                "path": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.ServicePortDTO": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "protocol": {
+                    "description": "TCP, UDP",
+                    "type": "string"
+                },
+                "targetPort": {
+                    "description": "по умолчанию равен port",
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule.ServiceSpecDTO": {
+            "type": "object",
+            "properties": {
+                "ports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schedule.ServicePortDTO"
+                    }
+                },
+                "type": {
+                    "description": "ClusterIP (по умолчанию), NodePort, LoadBalancer",
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.TCPSocketActionDTO": {
+            "type": "object",
+            "properties": {
+                "port": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schedule.TargetRefDTO": {
+            "type": "object",
+            "properties": {
+                "apiVersion": {
+                    "description": "обязателен для видов кроме Deployment и StatefulSet",
+                    "type": "string"
+                },
+                "kind": {
+                    "description": "по умолчанию Deployment; любой вид с подресурсом /scale",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "namespace": {
+                    "type": "string"
+                }
+            }
+        },
         "schedule.TimeRangeDTO": {
             "type": "object",
             "properties": {
@@ -433,6 +1873,116 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "schedule.TolerationDTO": {
+            "type": "object",
+            "properties": {
+                "effect": {
+                    "description": "NoSchedule, PreferNoSchedule, NoExecute",
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "operator": {
+                    "description": "Equal (по умолчанию) или Exists",
+                    "type": "string"
+                },
+                "tolerationSeconds": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.TopologySpreadConstraintDTO": {
+            "type": "object",
+            "properties": {
+                "labelSelector": {
+                    "$ref": "#/definitions/schedule.LabelSelectorDTO"
+                },
+                "maxSkew": {
+                    "type": "integer"
+                },
+                "minDomains": {
+                    "type": "integer"
+                },
+                "topologyKey": {
+                    "description": "например topology.kubernetes.io/zone",
+                    "type": "string"
+                },
+                "whenUnsatisfiable": {
+                    "description": "DoNotSchedule (по умолчанию) или ScheduleAnyway",
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.VolumeDTO": {
+            "type": "object",
+            "properties": {
+                "configMap": {
+                    "$ref": "#/definitions/schedule.ObjectVolumeDTO"
+                },
+                "emptyDir": {
+                    "$ref": "#/definitions/schedule.EmptyDirVolumeDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "persistentVolumeClaim": {
+                    "$ref": "#/definitions/schedule.PersistentVolumeClaimDTO"
+                },
+                "projected": {
+                    "$ref": "#/definitions/schedule.ProjectedVolumeDTO"
+                },
+                "secret": {
+                    "$ref": "#/definitions/schedule.ObjectVolumeDTO"
+                }
+            }
+        },
+        "schedule.VolumeMountDTO": {
+            "type": "object",
+            "properties": {
+                "mountPath": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "readOnly": {
+                    "type": "boolean"
+                },
+                "subPath": {
+                    "type": "string"
+                }
+            }
+        },
+        "schedule.VolumeProjectionDTO": {
+            "type": "object",
+            "properties": {
+                "configMap": {
+                    "$ref": "#/definitions/schedule.ObjectVolumeDTO"
+                },
+                "secret": {
+                    "$ref": "#/definitions/schedule.ObjectVolumeDTO"
+                },
+                "serviceAccountToken": {
+                    "$ref": "#/definitions/schedule.ServiceAccountTokenProjectionDTO"
+                }
+            }
+        },
+        "schedule.WeightedPodAffinityTermDTO": {
+            "type": "object",
+            "properties": {
+                "term": {
+                    "$ref": "#/definitions/schedule.PodAffinityTermDTO"
+                },
+                "weight": {
+                    "description": "1-100",
+                    "type": "integer"
+                }
+            }
         }
     }
 }`
@@ -82,12 +82,20 @@ func (x *TimeRange) GetReplicas() int32 {
 }
 
 type Schedule struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Weekdays      map[string]*Schedule_DaySchedule `protobuf:"bytes,1,rep,name=weekdays,proto3" json:"weekdays,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Dates         map[string]*Schedule_DaySchedule `protobuf:"bytes,2,rep,name=dates,proto3" json:"dates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Exceptions    []string                         `protobuf:"bytes,3,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState           `protogen:"open.v1"`
+	Weekdays        map[string]*Schedule_DaySchedule `protobuf:"bytes,1,rep,name=weekdays,proto3" json:"weekdays,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Dates           map[string]*Schedule_DaySchedule `protobuf:"bytes,2,rep,name=dates,proto3" json:"dates,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Exceptions      []string                         `protobuf:"bytes,3,rep,name=exceptions,proto3" json:"exceptions,omitempty"`
+	Timezone        string                           `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`   // IANA, пусто = таймзона сервиса по умолчанию
+	Namespace       string                           `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"` // пусто = namespace сервиса по умолчанию
+	Scaling         *ScalingOptions                  `protobuf:"bytes,6,opt,name=scaling,proto3" json:"scaling,omitempty"`
+	DefaultReplicas int32                            `protobuf:"varint,7,opt,name=default_replicas,json=defaultReplicas,proto3" json:"default_replicas,omitempty"`                                 // число реплик вне окон расписания
+	Target          *TargetRef                       `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`                                                                           // существующий workload вместо application
+	Labels          map[string]string                `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // добавляются ко всем создаваемым объектам
+	Annotations     map[string]string                `protobuf:"bytes,10,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Triggers        []*MetricTrigger                 `protobuf:"bytes,11,rep,name=triggers,proto3" json:"triggers,omitempty"` // масштабируют выше окон расписания
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Schedule) Reset() {
@@ -141,27 +149,87 @@ func (x *Schedule) GetExceptions() []string {
 	return nil
 }
 
-type Application struct {
+func (x *Schedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Schedule) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Schedule) GetScaling() *ScalingOptions {
+	if x != nil {
+		return x.Scaling
+	}
+	return nil
+}
+
+func (x *Schedule) GetDefaultReplicas() int32 {
+	if x != nil {
+		return x.DefaultReplicas
+	}
+	return 0
+}
+
+func (x *Schedule) GetTarget() *TargetRef {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *Schedule) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Schedule) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *Schedule) GetTriggers() []*MetricTrigger {
+	if x != nil {
+		return x.Triggers
+	}
+	return nil
+}
+
+// TargetRef - workload, развёрнутый вне scale-handler; он только масштабируется
+type TargetRef struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Containers    []*Container           `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // по умолчанию Deployment; вид должен иметь подресурс /scale
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`                     // пусто = namespace расписания
+	ApiVersion    string                 `protobuf:"bytes,4,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"` // обязателен для видов кроме Deployment и StatefulSet
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Application) Reset() {
-	*x = Application{}
+func (x *TargetRef) Reset() {
+	*x = TargetRef{}
 	mi := &file_common_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Application) String() string {
+func (x *TargetRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Application) ProtoMessage() {}
+func (*TargetRef) ProtoMessage() {}
 
-func (x *Application) ProtoReflect() protoreflect.Message {
+func (x *TargetRef) ProtoReflect() protoreflect.Message {
 	mi := &file_common_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -173,45 +241,66 @@ func (x *Application) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Application.ProtoReflect.Descriptor instead.
-func (*Application) Descriptor() ([]byte, []int) {
+// Deprecated: Use TargetRef.ProtoReflect.Descriptor instead.
+func (*TargetRef) Descriptor() ([]byte, []int) {
 	return file_common_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Application) GetContainers() []*Container {
+func (x *TargetRef) GetKind() string {
 	if x != nil {
-		return x.Containers
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-type Container struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Image          string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
-	Ports          []*ContainerPort       `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
-	Env            []*EnvVar              `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`
-	Resources      *Resources             `protobuf:"bytes,5,opt,name=resources,proto3" json:"resources,omitempty"`
-	LivenessProbe  *Probe                 `protobuf:"bytes,6,opt,name=liveness_probe,json=livenessProbe,proto3" json:"liveness_probe,omitempty"`
-	ReadinessProbe *Probe                 `protobuf:"bytes,7,opt,name=readiness_probe,json=readinessProbe,proto3" json:"readiness_probe,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *TargetRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *Container) Reset() {
-	*x = Container{}
+func (x *TargetRef) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *TargetRef) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+// Параметры ScaledObject KEDA
+type ScalingOptions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MinReplicaCount *int32                 `protobuf:"varint,1,opt,name=min_replica_count,json=minReplicaCount,proto3,oneof" json:"min_replica_count,omitempty"`
+	MaxReplicaCount *int32                 `protobuf:"varint,2,opt,name=max_replica_count,json=maxReplicaCount,proto3,oneof" json:"max_replica_count,omitempty"`
+	CooldownPeriod  *int32                 `protobuf:"varint,3,opt,name=cooldown_period,json=cooldownPeriod,proto3,oneof" json:"cooldown_period,omitempty"`
+	PollingInterval *int32                 `protobuf:"varint,4,opt,name=polling_interval,json=pollingInterval,proto3,oneof" json:"polling_interval,omitempty"`
+	Fallback        *Fallback              `protobuf:"bytes,5,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Behavior        *ScalingBehavior       `protobuf:"bytes,6,opt,name=behavior,proto3" json:"behavior,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScalingOptions) Reset() {
+	*x = ScalingOptions{}
 	mi := &file_common_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Container) String() string {
+func (x *ScalingOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Container) ProtoMessage() {}
+func (*ScalingOptions) ProtoMessage() {}
 
-func (x *Container) ProtoReflect() protoreflect.Message {
+func (x *ScalingOptions) ProtoReflect() protoreflect.Message {
 	mi := &file_common_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -223,83 +312,3071 @@ func (x *Container) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Container.ProtoReflect.Descriptor instead.
-func (*Container) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScalingOptions.ProtoReflect.Descriptor instead.
+func (*ScalingOptions) Descriptor() ([]byte, []int) {
 	return file_common_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Container) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *ScalingOptions) GetMinReplicaCount() int32 {
+	if x != nil && x.MinReplicaCount != nil {
+		return *x.MinReplicaCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Container) GetImage() string {
-	if x != nil {
-		return x.Image
+func (x *ScalingOptions) GetMaxReplicaCount() int32 {
+	if x != nil && x.MaxReplicaCount != nil {
+		return *x.MaxReplicaCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Container) GetPorts() []*ContainerPort {
+func (x *ScalingOptions) GetCooldownPeriod() int32 {
+	if x != nil && x.CooldownPeriod != nil {
+		return *x.CooldownPeriod
+	}
+	return 0
+}
+
+func (x *ScalingOptions) GetPollingInterval() int32 {
+	if x != nil && x.PollingInterval != nil {
+		return *x.PollingInterval
+	}
+	return 0
+}
+
+func (x *ScalingOptions) GetFallback() *Fallback {
 	if x != nil {
-		return x.Ports
+		return x.Fallback
 	}
 	return nil
 }
 
-func (x *Container) GetEnv() []*EnvVar {
+func (x *ScalingOptions) GetBehavior() *ScalingBehavior {
 	if x != nil {
-		return x.Env
+		return x.Behavior
 	}
 	return nil
 }
 
-func (x *Container) GetResources() *Resources {
+type Fallback struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	FailureThreshold int32                  `protobuf:"varint,1,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	Replicas         int32                  `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Fallback) Reset() {
+	*x = Fallback{}
+	mi := &file_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Fallback) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Fallback) ProtoMessage() {}
+
+func (x *Fallback) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[4]
 	if x != nil {
-		return x.Resources
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Fallback.ProtoReflect.Descriptor instead.
+func (*Fallback) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Fallback) GetFailureThreshold() int32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+func (x *Fallback) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+type ScalingBehavior struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScaleUp       *ScalingRules          `protobuf:"bytes,1,opt,name=scale_up,json=scaleUp,proto3" json:"scale_up,omitempty"`
+	ScaleDown     *ScalingRules          `protobuf:"bytes,2,opt,name=scale_down,json=scaleDown,proto3" json:"scale_down,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScalingBehavior) Reset() {
+	*x = ScalingBehavior{}
+	mi := &file_common_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingBehavior) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingBehavior) ProtoMessage() {}
+
+func (x *ScalingBehavior) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingBehavior.ProtoReflect.Descriptor instead.
+func (*ScalingBehavior) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScalingBehavior) GetScaleUp() *ScalingRules {
+	if x != nil {
+		return x.ScaleUp
 	}
 	return nil
 }
 
-func (x *Container) GetLivenessProbe() *Probe {
+func (x *ScalingBehavior) GetScaleDown() *ScalingRules {
 	if x != nil {
-		return x.LivenessProbe
+		return x.ScaleDown
 	}
 	return nil
 }
 
-func (x *Container) GetReadinessProbe() *Probe {
+type ScalingRules struct {
+	state                      protoimpl.MessageState `protogen:"open.v1"`
+	StabilizationWindowSeconds *int32                 `protobuf:"varint,1,opt,name=stabilization_window_seconds,json=stabilizationWindowSeconds,proto3,oneof" json:"stabilization_window_seconds,omitempty"`
+	SelectPolicy               string                 `protobuf:"bytes,2,opt,name=select_policy,json=selectPolicy,proto3" json:"select_policy,omitempty"` // Max, Min, Disabled
+	Policies                   []*ScalingPolicy       `protobuf:"bytes,3,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ScalingRules) Reset() {
+	*x = ScalingRules{}
+	mi := &file_common_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingRules) ProtoMessage() {}
+
+func (x *ScalingRules) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[6]
 	if x != nil {
-		return x.ReadinessProbe
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingRules.ProtoReflect.Descriptor instead.
+func (*ScalingRules) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ScalingRules) GetStabilizationWindowSeconds() int32 {
+	if x != nil && x.StabilizationWindowSeconds != nil {
+		return *x.StabilizationWindowSeconds
+	}
+	return 0
+}
+
+func (x *ScalingRules) GetSelectPolicy() string {
+	if x != nil {
+		return x.SelectPolicy
+	}
+	return ""
+}
+
+func (x *ScalingRules) GetPolicies() []*ScalingPolicy {
+	if x != nil {
+		return x.Policies
 	}
 	return nil
 }
 
-type ContainerPort struct {
+type ScalingPolicy struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ContainerPort int32                  `protobuf:"varint,1,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
-	Protocol      string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"` // TCP, UDP
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // Pods, Percent
+	Value         int32                  `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	PeriodSeconds int32                  `protobuf:"varint,3,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ContainerPort) Reset() {
-	*x = ContainerPort{}
-	mi := &file_common_proto_msgTypes[4]
+func (x *ScalingPolicy) Reset() {
+	*x = ScalingPolicy{}
+	mi := &file_common_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingPolicy) ProtoMessage() {}
+
+func (x *ScalingPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingPolicy.ProtoReflect.Descriptor instead.
+func (*ScalingPolicy) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ScalingPolicy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ScalingPolicy) GetValue() int32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ScalingPolicy) GetPeriodSeconds() int32 {
+	if x != nil {
+		return x.PeriodSeconds
+	}
+	return 0
+}
+
+// MetricTrigger - триггер KEDA по метрике; окна расписания задают нижнюю
+// границу реплик, метрические триггеры масштабируют выше неё
+type MetricTrigger struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Type              string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // cpu, memory, prometheus, rabbitmq, kafka, aws-sqs-queue, redis
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MetricType        string                 `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"` // Utilization, AverageValue, Value
+	Metadata          map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AuthenticationRef *AuthenticationRef     `protobuf:"bytes,5,opt,name=authentication_ref,json=authenticationRef,proto3" json:"authentication_ref,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MetricTrigger) Reset() {
+	*x = MetricTrigger{}
+	mi := &file_common_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricTrigger) ProtoMessage() {}
+
+func (x *MetricTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricTrigger.ProtoReflect.Descriptor instead.
+func (*MetricTrigger) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MetricTrigger) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *MetricTrigger) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetricTrigger) GetMetricType() string {
+	if x != nil {
+		return x.MetricType
+	}
+	return ""
+}
+
+func (x *MetricTrigger) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *MetricTrigger) GetAuthenticationRef() *AuthenticationRef {
+	if x != nil {
+		return x.AuthenticationRef
+	}
+	return nil
+}
+
+type AuthenticationRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // TriggerAuthentication (по умолчанию), ClusterTriggerAuthentication
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthenticationRef) Reset() {
+	*x = AuthenticationRef{}
+	mi := &file_common_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthenticationRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthenticationRef) ProtoMessage() {}
+
+func (x *AuthenticationRef) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthenticationRef.ProtoReflect.Descriptor instead.
+func (*AuthenticationRef) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AuthenticationRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AuthenticationRef) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+// ScheduleStatus - состояние применения расписания в кластере
+type ScheduleStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Phase             string                 `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`                                        // Pending, Applied, Degraded
+	LastAppliedAt     string                 `protobuf:"bytes,2,opt,name=last_applied_at,json=lastAppliedAt,proto3" json:"last_applied_at,omitempty"` // RFC 3339, пусто = ещё не применялось
+	LastError         string                 `protobuf:"bytes,3,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	AppliedGeneration int64                  `protobuf:"varint,4,opt,name=applied_generation,json=appliedGeneration,proto3" json:"applied_generation,omitempty"`
+	Generation        int64                  `protobuf:"varint,5,opt,name=generation,proto3" json:"generation,omitempty"`
+	DefaultReplicas   int32                  `protobuf:"varint,6,opt,name=default_replicas,json=defaultReplicas,proto3" json:"default_replicas,omitempty"`   // реплик вне окон
+	MinReplicaCount   int32                  `protobuf:"varint,7,opt,name=min_replica_count,json=minReplicaCount,proto3" json:"min_replica_count,omitempty"` // действующий minReplicaCount, не ниже default_replicas
+	MaxReplicaCount   int32                  `protobuf:"varint,8,opt,name=max_replica_count,json=maxReplicaCount,proto3" json:"max_replica_count,omitempty"`
+	Pause             *PauseState            `protobuf:"bytes,9,opt,name=pause,proto3" json:"pause,omitempty"` // пусто = расписание активно
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ScheduleStatus) Reset() {
+	*x = ScheduleStatus{}
+	mi := &file_common_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleStatus) ProtoMessage() {}
+
+func (x *ScheduleStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleStatus.ProtoReflect.Descriptor instead.
+func (*ScheduleStatus) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ScheduleStatus) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *ScheduleStatus) GetLastAppliedAt() string {
+	if x != nil {
+		return x.LastAppliedAt
+	}
+	return ""
+}
+
+func (x *ScheduleStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ScheduleStatus) GetAppliedGeneration() int64 {
+	if x != nil {
+		return x.AppliedGeneration
+	}
+	return 0
+}
+
+func (x *ScheduleStatus) GetGeneration() int64 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ScheduleStatus) GetDefaultReplicas() int32 {
+	if x != nil {
+		return x.DefaultReplicas
+	}
+	return 0
+}
+
+func (x *ScheduleStatus) GetMinReplicaCount() int32 {
+	if x != nil {
+		return x.MinReplicaCount
+	}
+	return 0
+}
+
+func (x *ScheduleStatus) GetMaxReplicaCount() int32 {
+	if x != nil {
+		return x.MaxReplicaCount
+	}
+	return 0
+}
+
+func (x *ScheduleStatus) GetPause() *PauseState {
+	if x != nil {
+		return x.Pause
+	}
+	return nil
+}
+
+// Manifest - объект Kubernetes, который создаёт расписание
+type Manifest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion    string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=content,proto3" json:"content,omitempty"` // JSON или YAML
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Manifest) Reset() {
+	*x = Manifest{}
+	mi := &file_common_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Manifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Manifest) ProtoMessage() {}
+
+func (x *Manifest) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Manifest.ProtoReflect.Descriptor instead.
+func (*Manifest) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Manifest) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *Manifest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Manifest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Manifest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Manifest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+// Override - временное число реплик поверх окон расписания. Это нижняя
+// граница: HPA берёт максимум по триггерам, поэтому активное окно с большим
+// числом реплик перекрывает переопределение.
+type Override struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Replicas      int32                  `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"` // RFC 3339
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`     // RFC 3339
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Active        bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Override) Reset() {
+	*x = Override{}
+	mi := &file_common_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Override) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Override) ProtoMessage() {}
+
+func (x *Override) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Override.ProtoReflect.Descriptor instead.
+func (*Override) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Override) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Override) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *Override) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Override) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *Override) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Override) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Override) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// PauseState - KEDA держит workload на replicas, пока паузу не снимут
+type PauseState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Replicas      int32                  `protobuf:"varint,1,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	PausedBy      string                 `protobuf:"bytes,2,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	PausedAt      string                 `protobuf:"bytes,4,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"` // RFC 3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseState) Reset() {
+	*x = PauseState{}
+	mi := &file_common_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseState) ProtoMessage() {}
+
+func (x *PauseState) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseState.ProtoReflect.Descriptor instead.
+func (*PauseState) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PauseState) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *PauseState) GetPausedBy() string {
+	if x != nil {
+		return x.PausedBy
+	}
+	return ""
+}
+
+func (x *PauseState) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PauseState) GetPausedAt() string {
+	if x != nil {
+		return x.PausedAt
+	}
+	return ""
+}
+
+type Application struct {
+	state                         protoimpl.MessageState      `protogen:"open.v1"`
+	Containers                    []*Container                `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
+	Kind                          string                      `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // Deployment (по умолчанию) или StatefulSet
+	Service                       *ServiceSpec                `protobuf:"bytes,3,opt,name=service,proto3" json:"service,omitempty"`
+	Ingress                       *IngressSpec                `protobuf:"bytes,4,opt,name=ingress,proto3" json:"ingress,omitempty"` // требует service
+	Volumes                       []*Volume                   `protobuf:"bytes,5,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	NodeSelector                  map[string]string           `protobuf:"bytes,6,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Tolerations                   []*Toleration               `protobuf:"bytes,7,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
+	Affinity                      *Affinity                   `protobuf:"bytes,8,opt,name=affinity,proto3" json:"affinity,omitempty"`
+	TopologySpreadConstraints     []*TopologySpreadConstraint `protobuf:"bytes,9,rep,name=topology_spread_constraints,json=topologySpreadConstraints,proto3" json:"topology_spread_constraints,omitempty"`
+	PriorityClassName             string                      `protobuf:"bytes,10,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priority_class_name,omitempty"`
+	InitContainers                []*Container                `protobuf:"bytes,11,rep,name=init_containers,json=initContainers,proto3" json:"init_containers,omitempty"`
+	ImagePullSecrets              []string                    `protobuf:"bytes,12,rep,name=image_pull_secrets,json=imagePullSecrets,proto3" json:"image_pull_secrets,omitempty"`
+	ServiceAccountName            string                      `protobuf:"bytes,13,opt,name=service_account_name,json=serviceAccountName,proto3" json:"service_account_name,omitempty"`
+	TerminationGracePeriodSeconds *int64                      `protobuf:"varint,14,opt,name=termination_grace_period_seconds,json=terminationGracePeriodSeconds,proto3,oneof" json:"termination_grace_period_seconds,omitempty"`
+	SecurityContext               *PodSecurityContext         `protobuf:"bytes,15,opt,name=security_context,json=securityContext,proto3" json:"security_context,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *Application) Reset() {
+	*x = Application{}
+	mi := &file_common_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Application) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Application) ProtoMessage() {}
+
+func (x *Application) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Application.ProtoReflect.Descriptor instead.
+func (*Application) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Application) GetContainers() []*Container {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *Application) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Application) GetService() *ServiceSpec {
+	if x != nil {
+		return x.Service
+	}
+	return nil
+}
+
+func (x *Application) GetIngress() *IngressSpec {
+	if x != nil {
+		return x.Ingress
+	}
+	return nil
+}
+
+func (x *Application) GetVolumes() []*Volume {
+	if x != nil {
+		return x.Volumes
+	}
+	return nil
+}
+
+func (x *Application) GetNodeSelector() map[string]string {
+	if x != nil {
+		return x.NodeSelector
+	}
+	return nil
+}
+
+func (x *Application) GetTolerations() []*Toleration {
+	if x != nil {
+		return x.Tolerations
+	}
+	return nil
+}
+
+func (x *Application) GetAffinity() *Affinity {
+	if x != nil {
+		return x.Affinity
+	}
+	return nil
+}
+
+func (x *Application) GetTopologySpreadConstraints() []*TopologySpreadConstraint {
+	if x != nil {
+		return x.TopologySpreadConstraints
+	}
+	return nil
+}
+
+func (x *Application) GetPriorityClassName() string {
+	if x != nil {
+		return x.PriorityClassName
+	}
+	return ""
+}
+
+func (x *Application) GetInitContainers() []*Container {
+	if x != nil {
+		return x.InitContainers
+	}
+	return nil
+}
+
+func (x *Application) GetImagePullSecrets() []string {
+	if x != nil {
+		return x.ImagePullSecrets
+	}
+	return nil
+}
+
+func (x *Application) GetServiceAccountName() string {
+	if x != nil {
+		return x.ServiceAccountName
+	}
+	return ""
+}
+
+func (x *Application) GetTerminationGracePeriodSeconds() int64 {
+	if x != nil && x.TerminationGracePeriodSeconds != nil {
+		return *x.TerminationGracePeriodSeconds
+	}
+	return 0
+}
+
+func (x *Application) GetSecurityContext() *PodSecurityContext {
+	if x != nil {
+		return x.SecurityContext
+	}
+	return nil
+}
+
+type PodSecurityContext struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RunAsUser     *int64                 `protobuf:"varint,1,opt,name=run_as_user,json=runAsUser,proto3,oneof" json:"run_as_user,omitempty"`
+	RunAsGroup    *int64                 `protobuf:"varint,2,opt,name=run_as_group,json=runAsGroup,proto3,oneof" json:"run_as_group,omitempty"`
+	RunAsNonRoot  *bool                  `protobuf:"varint,3,opt,name=run_as_non_root,json=runAsNonRoot,proto3,oneof" json:"run_as_non_root,omitempty"`
+	FsGroup       *int64                 `protobuf:"varint,4,opt,name=fs_group,json=fsGroup,proto3,oneof" json:"fs_group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodSecurityContext) Reset() {
+	*x = PodSecurityContext{}
+	mi := &file_common_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodSecurityContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodSecurityContext) ProtoMessage() {}
+
+func (x *PodSecurityContext) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodSecurityContext.ProtoReflect.Descriptor instead.
+func (*PodSecurityContext) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PodSecurityContext) GetRunAsUser() int64 {
+	if x != nil && x.RunAsUser != nil {
+		return *x.RunAsUser
+	}
+	return 0
+}
+
+func (x *PodSecurityContext) GetRunAsGroup() int64 {
+	if x != nil && x.RunAsGroup != nil {
+		return *x.RunAsGroup
+	}
+	return 0
+}
+
+func (x *PodSecurityContext) GetRunAsNonRoot() bool {
+	if x != nil && x.RunAsNonRoot != nil {
+		return *x.RunAsNonRoot
+	}
+	return false
+}
+
+func (x *PodSecurityContext) GetFsGroup() int64 {
+	if x != nil && x.FsGroup != nil {
+		return *x.FsGroup
+	}
+	return 0
+}
+
+type Toleration struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Key               string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator          string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"` // Equal (по умолчанию) или Exists
+	Value             string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Effect            string                 `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"` // NoSchedule, PreferNoSchedule, NoExecute
+	TolerationSeconds *int64                 `protobuf:"varint,5,opt,name=toleration_seconds,json=tolerationSeconds,proto3,oneof" json:"toleration_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Toleration) Reset() {
+	*x = Toleration{}
+	mi := &file_common_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Toleration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toleration) ProtoMessage() {}
+
+func (x *Toleration) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toleration.ProtoReflect.Descriptor instead.
+func (*Toleration) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Toleration) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Toleration) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Toleration) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Toleration) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *Toleration) GetTolerationSeconds() int64 {
+	if x != nil && x.TolerationSeconds != nil {
+		return *x.TolerationSeconds
+	}
+	return 0
+}
+
+type Affinity struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	NodeAffinity    *NodeAffinity          `protobuf:"bytes,1,opt,name=node_affinity,json=nodeAffinity,proto3" json:"node_affinity,omitempty"`
+	PodAffinity     *PodAffinity           `protobuf:"bytes,2,opt,name=pod_affinity,json=podAffinity,proto3" json:"pod_affinity,omitempty"`
+	PodAntiAffinity *PodAffinity           `protobuf:"bytes,3,opt,name=pod_anti_affinity,json=podAntiAffinity,proto3" json:"pod_anti_affinity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Affinity) Reset() {
+	*x = Affinity{}
+	mi := &file_common_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Affinity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Affinity) ProtoMessage() {}
+
+func (x *Affinity) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Affinity.ProtoReflect.Descriptor instead.
+func (*Affinity) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Affinity) GetNodeAffinity() *NodeAffinity {
+	if x != nil {
+		return x.NodeAffinity
+	}
+	return nil
+}
+
+func (x *Affinity) GetPodAffinity() *PodAffinity {
+	if x != nil {
+		return x.PodAffinity
+	}
+	return nil
+}
+
+func (x *Affinity) GetPodAntiAffinity() *PodAffinity {
+	if x != nil {
+		return x.PodAntiAffinity
+	}
+	return nil
+}
+
+type NodeAffinity struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Required      []*NodeSelectorTerm        `protobuf:"bytes,1,rep,name=required,proto3" json:"required,omitempty"` // термы объединяются через ИЛИ
+	Preferred     []*PreferredSchedulingTerm `protobuf:"bytes,2,rep,name=preferred,proto3" json:"preferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodeAffinity) Reset() {
+	*x = NodeAffinity{}
+	mi := &file_common_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeAffinity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeAffinity) ProtoMessage() {}
+
+func (x *NodeAffinity) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeAffinity.ProtoReflect.Descriptor instead.
+func (*NodeAffinity) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *NodeAffinity) GetRequired() []*NodeSelectorTerm {
+	if x != nil {
+		return x.Required
+	}
+	return nil
+}
+
+func (x *NodeAffinity) GetPreferred() []*PreferredSchedulingTerm {
+	if x != nil {
+		return x.Preferred
+	}
+	return nil
+}
+
+type NodeSelectorTerm struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MatchExpressions []*SelectorRequirement `protobuf:"bytes,1,rep,name=match_expressions,json=matchExpressions,proto3" json:"match_expressions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *NodeSelectorTerm) Reset() {
+	*x = NodeSelectorTerm{}
+	mi := &file_common_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeSelectorTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeSelectorTerm) ProtoMessage() {}
+
+func (x *NodeSelectorTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeSelectorTerm.ProtoReflect.Descriptor instead.
+func (*NodeSelectorTerm) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *NodeSelectorTerm) GetMatchExpressions() []*SelectorRequirement {
+	if x != nil {
+		return x.MatchExpressions
+	}
+	return nil
+}
+
+type PreferredSchedulingTerm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weight        int32                  `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"` // 1-100
+	Preference    *NodeSelectorTerm      `protobuf:"bytes,2,opt,name=preference,proto3" json:"preference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreferredSchedulingTerm) Reset() {
+	*x = PreferredSchedulingTerm{}
+	mi := &file_common_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreferredSchedulingTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferredSchedulingTerm) ProtoMessage() {}
+
+func (x *PreferredSchedulingTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferredSchedulingTerm.ProtoReflect.Descriptor instead.
+func (*PreferredSchedulingTerm) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PreferredSchedulingTerm) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *PreferredSchedulingTerm) GetPreference() *NodeSelectorTerm {
+	if x != nil {
+		return x.Preference
+	}
+	return nil
+}
+
+type SelectorRequirement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator      string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"` // In, NotIn, Exists, DoesNotExist, Gt, Lt
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelectorRequirement) Reset() {
+	*x = SelectorRequirement{}
+	mi := &file_common_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelectorRequirement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelectorRequirement) ProtoMessage() {}
+
+func (x *SelectorRequirement) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelectorRequirement.ProtoReflect.Descriptor instead.
+func (*SelectorRequirement) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SelectorRequirement) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SelectorRequirement) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *SelectorRequirement) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type PodAffinity struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Required      []*PodAffinityTerm         `protobuf:"bytes,1,rep,name=required,proto3" json:"required,omitempty"`
+	Preferred     []*WeightedPodAffinityTerm `protobuf:"bytes,2,rep,name=preferred,proto3" json:"preferred,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodAffinity) Reset() {
+	*x = PodAffinity{}
+	mi := &file_common_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodAffinity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodAffinity) ProtoMessage() {}
+
+func (x *PodAffinity) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodAffinity.ProtoReflect.Descriptor instead.
+func (*PodAffinity) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PodAffinity) GetRequired() []*PodAffinityTerm {
+	if x != nil {
+		return x.Required
+	}
+	return nil
+}
+
+func (x *PodAffinity) GetPreferred() []*WeightedPodAffinityTerm {
+	if x != nil {
+		return x.Preferred
+	}
+	return nil
+}
+
+// PodAffinityTerm - без label_selector выбираются поды самого приложения
+type PodAffinityTerm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LabelSelector *LabelSelector         `protobuf:"bytes,1,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	TopologyKey   string                 `protobuf:"bytes,2,opt,name=topology_key,json=topologyKey,proto3" json:"topology_key,omitempty"`
+	Namespaces    []string               `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PodAffinityTerm) Reset() {
+	*x = PodAffinityTerm{}
+	mi := &file_common_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodAffinityTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodAffinityTerm) ProtoMessage() {}
+
+func (x *PodAffinityTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodAffinityTerm.ProtoReflect.Descriptor instead.
+func (*PodAffinityTerm) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PodAffinityTerm) GetLabelSelector() *LabelSelector {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+func (x *PodAffinityTerm) GetTopologyKey() string {
+	if x != nil {
+		return x.TopologyKey
+	}
+	return ""
+}
+
+func (x *PodAffinityTerm) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+type WeightedPodAffinityTerm struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weight        int32                  `protobuf:"varint,1,opt,name=weight,proto3" json:"weight,omitempty"` // 1-100
+	Term          *PodAffinityTerm       `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WeightedPodAffinityTerm) Reset() {
+	*x = WeightedPodAffinityTerm{}
+	mi := &file_common_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WeightedPodAffinityTerm) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WeightedPodAffinityTerm) ProtoMessage() {}
+
+func (x *WeightedPodAffinityTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WeightedPodAffinityTerm.ProtoReflect.Descriptor instead.
+func (*WeightedPodAffinityTerm) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *WeightedPodAffinityTerm) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *WeightedPodAffinityTerm) GetTerm() *PodAffinityTerm {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type LabelSelector struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	MatchLabels      map[string]string      `protobuf:"bytes,1,rep,name=match_labels,json=matchLabels,proto3" json:"match_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MatchExpressions []*SelectorRequirement `protobuf:"bytes,2,rep,name=match_expressions,json=matchExpressions,proto3" json:"match_expressions,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *LabelSelector) Reset() {
+	*x = LabelSelector{}
+	mi := &file_common_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LabelSelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelSelector) ProtoMessage() {}
+
+func (x *LabelSelector) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelSelector.ProtoReflect.Descriptor instead.
+func (*LabelSelector) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *LabelSelector) GetMatchLabels() map[string]string {
+	if x != nil {
+		return x.MatchLabels
+	}
+	return nil
+}
+
+func (x *LabelSelector) GetMatchExpressions() []*SelectorRequirement {
+	if x != nil {
+		return x.MatchExpressions
+	}
+	return nil
+}
+
+// TopologySpreadConstraint - без label_selector распределяются поды самого приложения
+type TopologySpreadConstraint struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaxSkew           int32                  `protobuf:"varint,1,opt,name=max_skew,json=maxSkew,proto3" json:"max_skew,omitempty"`
+	TopologyKey       string                 `protobuf:"bytes,2,opt,name=topology_key,json=topologyKey,proto3" json:"topology_key,omitempty"`
+	WhenUnsatisfiable string                 `protobuf:"bytes,3,opt,name=when_unsatisfiable,json=whenUnsatisfiable,proto3" json:"when_unsatisfiable,omitempty"` // DoNotSchedule (по умолчанию) или ScheduleAnyway
+	LabelSelector     *LabelSelector         `protobuf:"bytes,4,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	MinDomains        int32                  `protobuf:"varint,5,opt,name=min_domains,json=minDomains,proto3" json:"min_domains,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TopologySpreadConstraint) Reset() {
+	*x = TopologySpreadConstraint{}
+	mi := &file_common_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopologySpreadConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopologySpreadConstraint) ProtoMessage() {}
+
+func (x *TopologySpreadConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopologySpreadConstraint.ProtoReflect.Descriptor instead.
+func (*TopologySpreadConstraint) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TopologySpreadConstraint) GetMaxSkew() int32 {
+	if x != nil {
+		return x.MaxSkew
+	}
+	return 0
+}
+
+func (x *TopologySpreadConstraint) GetTopologyKey() string {
+	if x != nil {
+		return x.TopologyKey
+	}
+	return ""
+}
+
+func (x *TopologySpreadConstraint) GetWhenUnsatisfiable() string {
+	if x != nil {
+		return x.WhenUnsatisfiable
+	}
+	return ""
+}
+
+func (x *TopologySpreadConstraint) GetLabelSelector() *LabelSelector {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+func (x *TopologySpreadConstraint) GetMinDomains() int32 {
+	if x != nil {
+		return x.MinDomains
+	}
+	return 0
+}
+
+// ServiceSpec - без портов публикуются все порты контейнеров
+type ServiceSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // ClusterIP (по умолчанию), NodePort, LoadBalancer
+	Ports         []*ServicePort         `protobuf:"bytes,2,rep,name=ports,proto3" json:"ports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceSpec) Reset() {
+	*x = ServiceSpec{}
+	mi := &file_common_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceSpec) ProtoMessage() {}
+
+func (x *ServiceSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceSpec.ProtoReflect.Descriptor instead.
+func (*ServiceSpec) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ServiceSpec) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ServiceSpec) GetPorts() []*ServicePort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+type ServicePort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	TargetPort    int32                  `protobuf:"varint,3,opt,name=target_port,json=targetPort,proto3" json:"target_port,omitempty"` // по умолчанию равен port
+	Protocol      string                 `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`                        // TCP, UDP
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServicePort) Reset() {
+	*x = ServicePort{}
+	mi := &file_common_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServicePort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServicePort) ProtoMessage() {}
+
+func (x *ServicePort) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServicePort.ProtoReflect.Descriptor instead.
+func (*ServicePort) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ServicePort) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServicePort) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ServicePort) GetTargetPort() int32 {
+	if x != nil {
+		return x.TargetPort
+	}
+	return 0
+}
+
+func (x *ServicePort) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+type IngressSpec struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClassName     string                 `protobuf:"bytes,1,opt,name=class_name,json=className,proto3" json:"class_name,omitempty"`
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`                                   // по умолчанию "/"
+	PathType      string                 `protobuf:"bytes,4,opt,name=path_type,json=pathType,proto3" json:"path_type,omitempty"`           // Prefix (по умолчанию), Exact, ImplementationSpecific
+	ServicePort   int32                  `protobuf:"varint,5,opt,name=service_port,json=servicePort,proto3" json:"service_port,omitempty"` // по умолчанию первый порт Service
+	TlsSecretName string                 `protobuf:"bytes,6,opt,name=tls_secret_name,json=tlsSecretName,proto3" json:"tls_secret_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngressSpec) Reset() {
+	*x = IngressSpec{}
+	mi := &file_common_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngressSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngressSpec) ProtoMessage() {}
+
+func (x *IngressSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngressSpec.ProtoReflect.Descriptor instead.
+func (*IngressSpec) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *IngressSpec) GetClassName() string {
+	if x != nil {
+		return x.ClassName
+	}
+	return ""
+}
+
+func (x *IngressSpec) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *IngressSpec) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *IngressSpec) GetPathType() string {
+	if x != nil {
+		return x.PathType
+	}
+	return ""
+}
+
+func (x *IngressSpec) GetServicePort() int32 {
+	if x != nil {
+		return x.ServicePort
+	}
+	return 0
+}
+
+func (x *IngressSpec) GetTlsSecretName() string {
+	if x != nil {
+		return x.TlsSecretName
+	}
+	return ""
+}
+
+type Container struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Image           string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	Ports           []*ContainerPort       `protobuf:"bytes,3,rep,name=ports,proto3" json:"ports,omitempty"`
+	Env             []*EnvVar              `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`
+	Resources       *Resources             `protobuf:"bytes,5,opt,name=resources,proto3" json:"resources,omitempty"`
+	LivenessProbe   *Probe                 `protobuf:"bytes,6,opt,name=liveness_probe,json=livenessProbe,proto3" json:"liveness_probe,omitempty"`
+	ReadinessProbe  *Probe                 `protobuf:"bytes,7,opt,name=readiness_probe,json=readinessProbe,proto3" json:"readiness_probe,omitempty"`
+	EnvFrom         []*EnvFromSource       `protobuf:"bytes,8,rep,name=env_from,json=envFrom,proto3" json:"env_from,omitempty"`
+	VolumeMounts    []*VolumeMount         `protobuf:"bytes,9,rep,name=volume_mounts,json=volumeMounts,proto3" json:"volume_mounts,omitempty"`
+	StartupProbe    *Probe                 `protobuf:"bytes,10,opt,name=startup_probe,json=startupProbe,proto3" json:"startup_probe,omitempty"`
+	Command         []string               `protobuf:"bytes,11,rep,name=command,proto3" json:"command,omitempty"`
+	Args            []string               `protobuf:"bytes,12,rep,name=args,proto3" json:"args,omitempty"`
+	ImagePullPolicy string                 `protobuf:"bytes,13,opt,name=image_pull_policy,json=imagePullPolicy,proto3" json:"image_pull_policy,omitempty"` // Always, IfNotPresent, Never
+	SecurityContext *SecurityContext       `protobuf:"bytes,14,opt,name=security_context,json=securityContext,proto3" json:"security_context,omitempty"`
+	Lifecycle       *Lifecycle             `protobuf:"bytes,15,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Container) Reset() {
+	*x = Container{}
+	mi := &file_common_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Container) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Container) ProtoMessage() {}
+
+func (x *Container) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Container.ProtoReflect.Descriptor instead.
+func (*Container) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Container) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Container) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *Container) GetPorts() []*ContainerPort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *Container) GetEnv() []*EnvVar {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Container) GetResources() *Resources {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *Container) GetLivenessProbe() *Probe {
+	if x != nil {
+		return x.LivenessProbe
+	}
+	return nil
+}
+
+func (x *Container) GetReadinessProbe() *Probe {
+	if x != nil {
+		return x.ReadinessProbe
+	}
+	return nil
+}
+
+func (x *Container) GetEnvFrom() []*EnvFromSource {
+	if x != nil {
+		return x.EnvFrom
+	}
+	return nil
+}
+
+func (x *Container) GetVolumeMounts() []*VolumeMount {
+	if x != nil {
+		return x.VolumeMounts
+	}
+	return nil
+}
+
+func (x *Container) GetStartupProbe() *Probe {
+	if x != nil {
+		return x.StartupProbe
+	}
+	return nil
+}
+
+func (x *Container) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *Container) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Container) GetImagePullPolicy() string {
+	if x != nil {
+		return x.ImagePullPolicy
+	}
+	return ""
+}
+
+func (x *Container) GetSecurityContext() *SecurityContext {
+	if x != nil {
+		return x.SecurityContext
+	}
+	return nil
+}
+
+func (x *Container) GetLifecycle() *Lifecycle {
+	if x != nil {
+		return x.Lifecycle
+	}
+	return nil
+}
+
+type SecurityContext struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	RunAsUser                *int64                 `protobuf:"varint,1,opt,name=run_as_user,json=runAsUser,proto3,oneof" json:"run_as_user,omitempty"`
+	RunAsGroup               *int64                 `protobuf:"varint,2,opt,name=run_as_group,json=runAsGroup,proto3,oneof" json:"run_as_group,omitempty"`
+	RunAsNonRoot             *bool                  `protobuf:"varint,3,opt,name=run_as_non_root,json=runAsNonRoot,proto3,oneof" json:"run_as_non_root,omitempty"`
+	ReadOnlyRootFilesystem   *bool                  `protobuf:"varint,4,opt,name=read_only_root_filesystem,json=readOnlyRootFilesystem,proto3,oneof" json:"read_only_root_filesystem,omitempty"`
+	AllowPrivilegeEscalation *bool                  `protobuf:"varint,5,opt,name=allow_privilege_escalation,json=allowPrivilegeEscalation,proto3,oneof" json:"allow_privilege_escalation,omitempty"`
+	Privileged               *bool                  `protobuf:"varint,6,opt,name=privileged,proto3,oneof" json:"privileged,omitempty"`
+	Capabilities             *Capabilities          `protobuf:"bytes,7,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SecurityContext) Reset() {
+	*x = SecurityContext{}
+	mi := &file_common_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SecurityContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SecurityContext) ProtoMessage() {}
+
+func (x *SecurityContext) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SecurityContext.ProtoReflect.Descriptor instead.
+func (*SecurityContext) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SecurityContext) GetRunAsUser() int64 {
+	if x != nil && x.RunAsUser != nil {
+		return *x.RunAsUser
+	}
+	return 0
+}
+
+func (x *SecurityContext) GetRunAsGroup() int64 {
+	if x != nil && x.RunAsGroup != nil {
+		return *x.RunAsGroup
+	}
+	return 0
+}
+
+func (x *SecurityContext) GetRunAsNonRoot() bool {
+	if x != nil && x.RunAsNonRoot != nil {
+		return *x.RunAsNonRoot
+	}
+	return false
+}
+
+func (x *SecurityContext) GetReadOnlyRootFilesystem() bool {
+	if x != nil && x.ReadOnlyRootFilesystem != nil {
+		return *x.ReadOnlyRootFilesystem
+	}
+	return false
+}
+
+func (x *SecurityContext) GetAllowPrivilegeEscalation() bool {
+	if x != nil && x.AllowPrivilegeEscalation != nil {
+		return *x.AllowPrivilegeEscalation
+	}
+	return false
+}
+
+func (x *SecurityContext) GetPrivileged() bool {
+	if x != nil && x.Privileged != nil {
+		return *x.Privileged
+	}
+	return false
+}
+
+func (x *SecurityContext) GetCapabilities() *Capabilities {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+type Capabilities struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Add           []string               `protobuf:"bytes,1,rep,name=add,proto3" json:"add,omitempty"`
+	Drop          []string               `protobuf:"bytes,2,rep,name=drop,proto3" json:"drop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Capabilities) Reset() {
+	*x = Capabilities{}
+	mi := &file_common_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Capabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Capabilities) ProtoMessage() {}
+
+func (x *Capabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Capabilities.ProtoReflect.Descriptor instead.
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Capabilities) GetAdd() []string {
+	if x != nil {
+		return x.Add
+	}
+	return nil
+}
+
+func (x *Capabilities) GetDrop() []string {
+	if x != nil {
+		return x.Drop
+	}
+	return nil
+}
+
+type Lifecycle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PostStart     *LifecycleHandler      `protobuf:"bytes,1,opt,name=post_start,json=postStart,proto3" json:"post_start,omitempty"`
+	PreStop       *LifecycleHandler      `protobuf:"bytes,2,opt,name=pre_stop,json=preStop,proto3" json:"pre_stop,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lifecycle) Reset() {
+	*x = Lifecycle{}
+	mi := &file_common_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lifecycle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lifecycle) ProtoMessage() {}
+
+func (x *Lifecycle) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lifecycle.ProtoReflect.Descriptor instead.
+func (*Lifecycle) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Lifecycle) GetPostStart() *LifecycleHandler {
+	if x != nil {
+		return x.PostStart
+	}
+	return nil
+}
+
+func (x *Lifecycle) GetPreStop() *LifecycleHandler {
+	if x != nil {
+		return x.PreStop
+	}
+	return nil
+}
+
+// LifecycleHandler - задаётся ровно одно действие
+type LifecycleHandler struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exec          *ExecAction            `protobuf:"bytes,1,opt,name=exec,proto3" json:"exec,omitempty"`
+	HttpGet       *HttpGetAction         `protobuf:"bytes,2,opt,name=http_get,json=httpGet,proto3" json:"http_get,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LifecycleHandler) Reset() {
+	*x = LifecycleHandler{}
+	mi := &file_common_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LifecycleHandler) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LifecycleHandler) ProtoMessage() {}
+
+func (x *LifecycleHandler) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LifecycleHandler.ProtoReflect.Descriptor instead.
+func (*LifecycleHandler) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LifecycleHandler) GetExec() *ExecAction {
+	if x != nil {
+		return x.Exec
+	}
+	return nil
+}
+
+func (x *LifecycleHandler) GetHttpGet() *HttpGetAction {
+	if x != nil {
+		return x.HttpGet
+	}
+	return nil
+}
+
+type ContainerPort struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContainerPort int32                  `protobuf:"varint,1,opt,name=container_port,json=containerPort,proto3" json:"container_port,omitempty"`
+	Protocol      string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"` // TCP, UDP
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContainerPort) Reset() {
+	*x = ContainerPort{}
+	mi := &file_common_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContainerPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerPort) ProtoMessage() {}
+
+func (x *ContainerPort) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerPort.ProtoReflect.Descriptor instead.
+func (*ContainerPort) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ContainerPort) GetContainerPort() int32 {
+	if x != nil {
+		return x.ContainerPort
+	}
+	return 0
+}
+
+func (x *ContainerPort) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+type EnvVar struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ValueFrom     *EnvVarSource          `protobuf:"bytes,3,opt,name=value_from,json=valueFrom,proto3" json:"value_from,omitempty"` // вместо value
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvVar) Reset() {
+	*x = EnvVar{}
+	mi := &file_common_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvVar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvVar) ProtoMessage() {}
+
+func (x *EnvVar) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvVar.ProtoReflect.Descriptor instead.
+func (*EnvVar) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *EnvVar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnvVar) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *EnvVar) GetValueFrom() *EnvVarSource {
+	if x != nil {
+		return x.ValueFrom
+	}
+	return nil
+}
+
+type EnvVarSource struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SecretKeyRef    *KeySelector           `protobuf:"bytes,1,opt,name=secret_key_ref,json=secretKeyRef,proto3" json:"secret_key_ref,omitempty"`
+	ConfigMapKeyRef *KeySelector           `protobuf:"bytes,2,opt,name=config_map_key_ref,json=configMapKeyRef,proto3" json:"config_map_key_ref,omitempty"`
+	FieldRef        *FieldRef              `protobuf:"bytes,3,opt,name=field_ref,json=fieldRef,proto3" json:"field_ref,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EnvVarSource) Reset() {
+	*x = EnvVarSource{}
+	mi := &file_common_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvVarSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvVarSource) ProtoMessage() {}
+
+func (x *EnvVarSource) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvVarSource.ProtoReflect.Descriptor instead.
+func (*EnvVarSource) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *EnvVarSource) GetSecretKeyRef() *KeySelector {
+	if x != nil {
+		return x.SecretKeyRef
+	}
+	return nil
+}
+
+func (x *EnvVarSource) GetConfigMapKeyRef() *KeySelector {
+	if x != nil {
+		return x.ConfigMapKeyRef
+	}
+	return nil
+}
+
+func (x *EnvVarSource) GetFieldRef() *FieldRef {
+	if x != nil {
+		return x.FieldRef
+	}
+	return nil
+}
+
+type KeySelector struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Optional      bool                   `protobuf:"varint,3,opt,name=optional,proto3" json:"optional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeySelector) Reset() {
+	*x = KeySelector{}
+	mi := &file_common_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeySelector) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeySelector) ProtoMessage() {}
+
+func (x *KeySelector) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeySelector.ProtoReflect.Descriptor instead.
+func (*KeySelector) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *KeySelector) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KeySelector) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeySelector) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+type FieldRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FieldPath     string                 `protobuf:"bytes,1,opt,name=field_path,json=fieldPath,proto3" json:"field_path,omitempty"` // например metadata.name
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldRef) Reset() {
+	*x = FieldRef{}
+	mi := &file_common_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldRef) ProtoMessage() {}
+
+func (x *FieldRef) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldRef.ProtoReflect.Descriptor instead.
+func (*FieldRef) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *FieldRef) GetFieldPath() string {
+	if x != nil {
+		return x.FieldPath
+	}
+	return ""
+}
+
+type EnvFromSource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Prefix        string                 `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	SecretRef     *LocalObjectRef        `protobuf:"bytes,2,opt,name=secret_ref,json=secretRef,proto3" json:"secret_ref,omitempty"`
+	ConfigMapRef  *LocalObjectRef        `protobuf:"bytes,3,opt,name=config_map_ref,json=configMapRef,proto3" json:"config_map_ref,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnvFromSource) Reset() {
+	*x = EnvFromSource{}
+	mi := &file_common_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnvFromSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvFromSource) ProtoMessage() {}
+
+func (x *EnvFromSource) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvFromSource.ProtoReflect.Descriptor instead.
+func (*EnvFromSource) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *EnvFromSource) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *EnvFromSource) GetSecretRef() *LocalObjectRef {
+	if x != nil {
+		return x.SecretRef
+	}
+	return nil
+}
+
+func (x *EnvFromSource) GetConfigMapRef() *LocalObjectRef {
+	if x != nil {
+		return x.ConfigMapRef
+	}
+	return nil
+}
+
+type LocalObjectRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Optional      bool                   `protobuf:"varint,2,opt,name=optional,proto3" json:"optional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocalObjectRef) Reset() {
+	*x = LocalObjectRef{}
+	mi := &file_common_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocalObjectRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalObjectRef) ProtoMessage() {}
+
+func (x *LocalObjectRef) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalObjectRef.ProtoReflect.Descriptor instead.
+func (*LocalObjectRef) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *LocalObjectRef) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LocalObjectRef) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+// Volume - том пода, задаётся ровно один источник
+type Volume struct {
+	state                 protoimpl.MessageState       `protogen:"open.v1"`
+	Name                  string                       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	EmptyDir              *EmptyDirVolume              `protobuf:"bytes,2,opt,name=empty_dir,json=emptyDir,proto3" json:"empty_dir,omitempty"`
+	ConfigMap             *ObjectVolume                `protobuf:"bytes,3,opt,name=config_map,json=configMap,proto3" json:"config_map,omitempty"`
+	Secret                *ObjectVolume                `protobuf:"bytes,4,opt,name=secret,proto3" json:"secret,omitempty"`
+	PersistentVolumeClaim *PersistentVolumeClaimVolume `protobuf:"bytes,5,opt,name=persistent_volume_claim,json=persistentVolumeClaim,proto3" json:"persistent_volume_claim,omitempty"`
+	Projected             *ProjectedVolume             `protobuf:"bytes,6,opt,name=projected,proto3" json:"projected,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Volume) Reset() {
+	*x = Volume{}
+	mi := &file_common_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Volume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Volume) ProtoMessage() {}
+
+func (x *Volume) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Volume.ProtoReflect.Descriptor instead.
+func (*Volume) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Volume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Volume) GetEmptyDir() *EmptyDirVolume {
+	if x != nil {
+		return x.EmptyDir
+	}
+	return nil
+}
+
+func (x *Volume) GetConfigMap() *ObjectVolume {
+	if x != nil {
+		return x.ConfigMap
+	}
+	return nil
+}
+
+func (x *Volume) GetSecret() *ObjectVolume {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *Volume) GetPersistentVolumeClaim() *PersistentVolumeClaimVolume {
+	if x != nil {
+		return x.PersistentVolumeClaim
+	}
+	return nil
+}
+
+func (x *Volume) GetProjected() *ProjectedVolume {
+	if x != nil {
+		return x.Projected
+	}
+	return nil
+}
+
+type EmptyDirVolume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Medium        string                 `protobuf:"bytes,1,opt,name=medium,proto3" json:"medium,omitempty"` // "" или Memory
+	SizeLimit     string                 `protobuf:"bytes,2,opt,name=size_limit,json=sizeLimit,proto3" json:"size_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmptyDirVolume) Reset() {
+	*x = EmptyDirVolume{}
+	mi := &file_common_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmptyDirVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyDirVolume) ProtoMessage() {}
+
+func (x *EmptyDirVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyDirVolume.ProtoReflect.Descriptor instead.
+func (*EmptyDirVolume) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *EmptyDirVolume) GetMedium() string {
+	if x != nil {
+		return x.Medium
+	}
+	return ""
+}
+
+func (x *EmptyDirVolume) GetSizeLimit() string {
+	if x != nil {
+		return x.SizeLimit
+	}
+	return ""
+}
+
+// ObjectVolume - ConfigMap или Secret; без items монтируются все ключи
+type ObjectVolume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Items         []*KeyToPath           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	DefaultMode   int32                  `protobuf:"varint,3,opt,name=default_mode,json=defaultMode,proto3" json:"default_mode,omitempty"`
+	Optional      bool                   `protobuf:"varint,4,opt,name=optional,proto3" json:"optional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ObjectVolume) Reset() {
+	*x = ObjectVolume{}
+	mi := &file_common_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectVolume) ProtoMessage() {}
+
+func (x *ObjectVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectVolume.ProtoReflect.Descriptor instead.
+func (*ObjectVolume) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ObjectVolume) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ObjectVolume) GetItems() []*KeyToPath {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ObjectVolume) GetDefaultMode() int32 {
+	if x != nil {
+		return x.DefaultMode
+	}
+	return 0
+}
+
+func (x *ObjectVolume) GetOptional() bool {
+	if x != nil {
+		return x.Optional
+	}
+	return false
+}
+
+type KeyToPath struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Mode          int32                  `protobuf:"varint,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KeyToPath) Reset() {
+	*x = KeyToPath{}
+	mi := &file_common_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KeyToPath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyToPath) ProtoMessage() {}
+
+func (x *KeyToPath) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyToPath.ProtoReflect.Descriptor instead.
+func (*KeyToPath) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *KeyToPath) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *KeyToPath) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *KeyToPath) GetMode() int32 {
+	if x != nil {
+		return x.Mode
+	}
+	return 0
+}
+
+type PersistentVolumeClaimVolume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClaimName     string                 `protobuf:"bytes,1,opt,name=claim_name,json=claimName,proto3" json:"claim_name,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,2,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersistentVolumeClaimVolume) Reset() {
+	*x = PersistentVolumeClaimVolume{}
+	mi := &file_common_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersistentVolumeClaimVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersistentVolumeClaimVolume) ProtoMessage() {}
+
+func (x *PersistentVolumeClaimVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersistentVolumeClaimVolume.ProtoReflect.Descriptor instead.
+func (*PersistentVolumeClaimVolume) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PersistentVolumeClaimVolume) GetClaimName() string {
+	if x != nil {
+		return x.ClaimName
+	}
+	return ""
+}
+
+func (x *PersistentVolumeClaimVolume) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
+type ProjectedVolume struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sources       []*VolumeProjection    `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	DefaultMode   int32                  `protobuf:"varint,2,opt,name=default_mode,json=defaultMode,proto3" json:"default_mode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProjectedVolume) Reset() {
+	*x = ProjectedVolume{}
+	mi := &file_common_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectedVolume) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectedVolume) ProtoMessage() {}
+
+func (x *ProjectedVolume) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectedVolume.ProtoReflect.Descriptor instead.
+func (*ProjectedVolume) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ProjectedVolume) GetSources() []*VolumeProjection {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *ProjectedVolume) GetDefaultMode() int32 {
+	if x != nil {
+		return x.DefaultMode
+	}
+	return 0
+}
+
+type VolumeProjection struct {
+	state               protoimpl.MessageState         `protogen:"open.v1"`
+	ConfigMap           *ObjectVolume                  `protobuf:"bytes,1,opt,name=config_map,json=configMap,proto3" json:"config_map,omitempty"`
+	Secret              *ObjectVolume                  `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	ServiceAccountToken *ServiceAccountTokenProjection `protobuf:"bytes,3,opt,name=service_account_token,json=serviceAccountToken,proto3" json:"service_account_token,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *VolumeProjection) Reset() {
+	*x = VolumeProjection{}
+	mi := &file_common_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolumeProjection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolumeProjection) ProtoMessage() {}
+
+func (x *VolumeProjection) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolumeProjection.ProtoReflect.Descriptor instead.
+func (*VolumeProjection) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *VolumeProjection) GetConfigMap() *ObjectVolume {
+	if x != nil {
+		return x.ConfigMap
+	}
+	return nil
+}
+
+func (x *VolumeProjection) GetSecret() *ObjectVolume {
+	if x != nil {
+		return x.Secret
+	}
+	return nil
+}
+
+func (x *VolumeProjection) GetServiceAccountToken() *ServiceAccountTokenProjection {
+	if x != nil {
+		return x.ServiceAccountToken
+	}
+	return nil
+}
+
+type ServiceAccountTokenProjection struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Audience          string                 `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
+	ExpirationSeconds int64                  `protobuf:"varint,2,opt,name=expiration_seconds,json=expirationSeconds,proto3" json:"expiration_seconds,omitempty"`
+	Path              string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ServiceAccountTokenProjection) Reset() {
+	*x = ServiceAccountTokenProjection{}
+	mi := &file_common_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ContainerPort) String() string {
+func (x *ServiceAccountTokenProjection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContainerPort) ProtoMessage() {}
+func (*ServiceAccountTokenProjection) ProtoMessage() {}
 
-func (x *ContainerPort) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[4]
+func (x *ServiceAccountTokenProjection) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,48 +3387,57 @@ func (x *ContainerPort) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContainerPort.ProtoReflect.Descriptor instead.
-func (*ContainerPort) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ServiceAccountTokenProjection.ProtoReflect.Descriptor instead.
+func (*ServiceAccountTokenProjection) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ContainerPort) GetContainerPort() int32 {
+func (x *ServiceAccountTokenProjection) GetAudience() string {
 	if x != nil {
-		return x.ContainerPort
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *ServiceAccountTokenProjection) GetExpirationSeconds() int64 {
+	if x != nil {
+		return x.ExpirationSeconds
 	}
 	return 0
 }
 
-func (x *ContainerPort) GetProtocol() string {
+func (x *ServiceAccountTokenProjection) GetPath() string {
 	if x != nil {
-		return x.Protocol
+		return x.Path
 	}
 	return ""
 }
 
-type EnvVar struct {
+type VolumeMount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	MountPath     string                 `protobuf:"bytes,2,opt,name=mount_path,json=mountPath,proto3" json:"mount_path,omitempty"`
+	SubPath       string                 `protobuf:"bytes,3,opt,name=sub_path,json=subPath,proto3" json:"sub_path,omitempty"`
+	ReadOnly      bool                   `protobuf:"varint,4,opt,name=read_only,json=readOnly,proto3" json:"read_only,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EnvVar) Reset() {
-	*x = EnvVar{}
-	mi := &file_common_proto_msgTypes[5]
+func (x *VolumeMount) Reset() {
+	*x = VolumeMount{}
+	mi := &file_common_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EnvVar) String() string {
+func (x *VolumeMount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EnvVar) ProtoMessage() {}
+func (*VolumeMount) ProtoMessage() {}
 
-func (x *EnvVar) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[5]
+func (x *VolumeMount) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,25 +3448,39 @@ func (x *EnvVar) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EnvVar.ProtoReflect.Descriptor instead.
-func (*EnvVar) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use VolumeMount.ProtoReflect.Descriptor instead.
+func (*VolumeMount) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *EnvVar) GetName() string {
+func (x *VolumeMount) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *EnvVar) GetValue() string {
+func (x *VolumeMount) GetMountPath() string {
 	if x != nil {
-		return x.Value
+		return x.MountPath
+	}
+	return ""
+}
+
+func (x *VolumeMount) GetSubPath() string {
+	if x != nil {
+		return x.SubPath
 	}
 	return ""
 }
 
+func (x *VolumeMount) GetReadOnly() bool {
+	if x != nil {
+		return x.ReadOnly
+	}
+	return false
+}
+
 type Resources struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Requests      *ResourceQuantity      `protobuf:"bytes,1,opt,name=requests,proto3" json:"requests,omitempty"`
@@ -391,7 +3491,7 @@ type Resources struct {
 
 func (x *Resources) Reset() {
 	*x = Resources{}
-	mi := &file_common_proto_msgTypes[6]
+	mi := &file_common_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +3503,7 @@ func (x *Resources) String() string {
 func (*Resources) ProtoMessage() {}
 
 func (x *Resources) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[6]
+	mi := &file_common_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +3516,7 @@ func (x *Resources) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Resources.ProtoReflect.Descriptor instead.
 func (*Resources) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{6}
+	return file_common_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *Resources) GetRequests() *ResourceQuantity {
@@ -443,7 +3543,7 @@ type ResourceQuantity struct {
 
 func (x *ResourceQuantity) Reset() {
 	*x = ResourceQuantity{}
-	mi := &file_common_proto_msgTypes[7]
+	mi := &file_common_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -455,7 +3555,7 @@ func (x *ResourceQuantity) String() string {
 func (*ResourceQuantity) ProtoMessage() {}
 
 func (x *ResourceQuantity) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[7]
+	mi := &file_common_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -468,7 +3568,7 @@ func (x *ResourceQuantity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceQuantity.ProtoReflect.Descriptor instead.
 func (*ResourceQuantity) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{7}
+	return file_common_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ResourceQuantity) GetMemory() string {
@@ -485,18 +3585,25 @@ func (x *ResourceQuantity) GetCpu() string {
 	return ""
 }
 
+// Probe - задаётся ровно одно действие: http_get, tcp_socket, exec или grpc
 type Probe struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	HttpGet             *HttpGetAction         `protobuf:"bytes,1,opt,name=http_get,json=httpGet,proto3" json:"http_get,omitempty"`
 	InitialDelaySeconds int32                  `protobuf:"varint,2,opt,name=initial_delay_seconds,json=initialDelaySeconds,proto3" json:"initial_delay_seconds,omitempty"`
 	PeriodSeconds       int32                  `protobuf:"varint,3,opt,name=period_seconds,json=periodSeconds,proto3" json:"period_seconds,omitempty"`
+	TcpSocket           *TcpSocketAction       `protobuf:"bytes,4,opt,name=tcp_socket,json=tcpSocket,proto3" json:"tcp_socket,omitempty"`
+	Exec                *ExecAction            `protobuf:"bytes,5,opt,name=exec,proto3" json:"exec,omitempty"`
+	Grpc                *GrpcAction            `protobuf:"bytes,6,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	TimeoutSeconds      int32                  `protobuf:"varint,7,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	FailureThreshold    int32                  `protobuf:"varint,8,opt,name=failure_threshold,json=failureThreshold,proto3" json:"failure_threshold,omitempty"`
+	SuccessThreshold    int32                  `protobuf:"varint,9,opt,name=success_threshold,json=successThreshold,proto3" json:"success_threshold,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
 func (x *Probe) Reset() {
 	*x = Probe{}
-	mi := &file_common_proto_msgTypes[8]
+	mi := &file_common_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -508,7 +3615,7 @@ func (x *Probe) String() string {
 func (*Probe) ProtoMessage() {}
 
 func (x *Probe) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[8]
+	mi := &file_common_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -521,7 +3628,7 @@ func (x *Probe) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Probe.ProtoReflect.Descriptor instead.
 func (*Probe) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{8}
+	return file_common_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *Probe) GetHttpGet() *HttpGetAction {
@@ -545,17 +3652,61 @@ func (x *Probe) GetPeriodSeconds() int32 {
 	return 0
 }
 
+func (x *Probe) GetTcpSocket() *TcpSocketAction {
+	if x != nil {
+		return x.TcpSocket
+	}
+	return nil
+}
+
+func (x *Probe) GetExec() *ExecAction {
+	if x != nil {
+		return x.Exec
+	}
+	return nil
+}
+
+func (x *Probe) GetGrpc() *GrpcAction {
+	if x != nil {
+		return x.Grpc
+	}
+	return nil
+}
+
+func (x *Probe) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Probe) GetFailureThreshold() int32 {
+	if x != nil {
+		return x.FailureThreshold
+	}
+	return 0
+}
+
+func (x *Probe) GetSuccessThreshold() int32 {
+	if x != nil {
+		return x.SuccessThreshold
+	}
+	return 0
+}
+
 type HttpGetAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	Port          int32                  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Scheme        string                 `protobuf:"bytes,3,opt,name=scheme,proto3" json:"scheme,omitempty"` // HTTP (по умолчанию) или HTTPS
+	HttpHeaders   []*HttpHeader          `protobuf:"bytes,4,rep,name=http_headers,json=httpHeaders,proto3" json:"http_headers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *HttpGetAction) Reset() {
 	*x = HttpGetAction{}
-	mi := &file_common_proto_msgTypes[9]
+	mi := &file_common_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -567,7 +3718,7 @@ func (x *HttpGetAction) String() string {
 func (*HttpGetAction) ProtoMessage() {}
 
 func (x *HttpGetAction) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[9]
+	mi := &file_common_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -580,7 +3731,7 @@ func (x *HttpGetAction) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HttpGetAction.ProtoReflect.Descriptor instead.
 func (*HttpGetAction) Descriptor() ([]byte, []int) {
-	return file_common_proto_rawDescGZIP(), []int{9}
+	return file_common_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *HttpGetAction) GetPath() string {
@@ -597,6 +3748,212 @@ func (x *HttpGetAction) GetPort() int32 {
 	return 0
 }
 
+func (x *HttpGetAction) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *HttpGetAction) GetHttpHeaders() []*HttpHeader {
+	if x != nil {
+		return x.HttpHeaders
+	}
+	return nil
+}
+
+type HttpHeader struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HttpHeader) Reset() {
+	*x = HttpHeader{}
+	mi := &file_common_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HttpHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpHeader) ProtoMessage() {}
+
+func (x *HttpHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpHeader.ProtoReflect.Descriptor instead.
+func (*HttpHeader) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *HttpHeader) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *HttpHeader) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type TcpSocketAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Port          int32                  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TcpSocketAction) Reset() {
+	*x = TcpSocketAction{}
+	mi := &file_common_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TcpSocketAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TcpSocketAction) ProtoMessage() {}
+
+func (x *TcpSocketAction) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TcpSocketAction.ProtoReflect.Descriptor instead.
+func (*TcpSocketAction) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *TcpSocketAction) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type ExecAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Command       []string               `protobuf:"bytes,1,rep,name=command,proto3" json:"command,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecAction) Reset() {
+	*x = ExecAction{}
+	mi := &file_common_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecAction) ProtoMessage() {}
+
+func (x *ExecAction) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecAction.ProtoReflect.Descriptor instead.
+func (*ExecAction) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ExecAction) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+type GrpcAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Port          int32                  `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Service       string                 `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GrpcAction) Reset() {
+	*x = GrpcAction{}
+	mi := &file_common_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GrpcAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrpcAction) ProtoMessage() {}
+
+func (x *GrpcAction) ProtoReflect() protoreflect.Message {
+	mi := &file_common_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrpcAction.ProtoReflect.Descriptor instead.
+func (*GrpcAction) Descriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GrpcAction) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *GrpcAction) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
 type Schedule_DaySchedule struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TimeRanges    []*TimeRange           `protobuf:"bytes,1,rep,name=time_ranges,json=timeRanges,proto3" json:"time_ranges,omitempty"`
@@ -606,7 +3963,7 @@ type Schedule_DaySchedule struct {
 
 func (x *Schedule_DaySchedule) Reset() {
 	*x = Schedule_DaySchedule{}
-	mi := &file_common_proto_msgTypes[10]
+	mi := &file_common_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -618,7 +3975,7 @@ func (x *Schedule_DaySchedule) String() string {
 func (*Schedule_DaySchedule) ProtoMessage() {}
 
 func (x *Schedule_DaySchedule) ProtoReflect() protoreflect.Message {
-	mi := &file_common_proto_msgTypes[10]
+	mi := &file_common_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,13 +4006,22 @@ const file_common_proto_rawDesc = "" +
 	"\tTimeRange\x12\x12\n" +
 	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
 	"\x02to\x18\x02 \x01(\tR\x02to\x12\x1a\n" +
-	"\breplicas\x18\x03 \x01(\x05R\breplicas\"\xad\x03\n" +
+	"\breplicas\x18\x03 \x01(\x05R\breplicas\"\xb6\a\n" +
 	"\bSchedule\x12@\n" +
 	"\bweekdays\x18\x01 \x03(\v2$.scalehandler.Schedule.WeekdaysEntryR\bweekdays\x127\n" +
 	"\x05dates\x18\x02 \x03(\v2!.scalehandler.Schedule.DatesEntryR\x05dates\x12\x1e\n" +
 	"\n" +
 	"exceptions\x18\x03 \x03(\tR\n" +
-	"exceptions\x1aG\n" +
+	"exceptions\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x1c\n" +
+	"\tnamespace\x18\x05 \x01(\tR\tnamespace\x126\n" +
+	"\ascaling\x18\x06 \x01(\v2\x1c.scalehandler.ScalingOptionsR\ascaling\x12)\n" +
+	"\x10default_replicas\x18\a \x01(\x05R\x0fdefaultReplicas\x12/\n" +
+	"\x06target\x18\b \x01(\v2\x17.scalehandler.TargetRefR\x06target\x12:\n" +
+	"\x06labels\x18\t \x03(\v2\".scalehandler.Schedule.LabelsEntryR\x06labels\x12I\n" +
+	"\vannotations\x18\n" +
+	" \x03(\v2'.scalehandler.Schedule.AnnotationsEntryR\vannotations\x127\n" +
+	"\btriggers\x18\v \x03(\v2\x1b.scalehandler.MetricTriggerR\btriggers\x1aG\n" +
 	"\vDaySchedule\x128\n" +
 	"\vtime_ranges\x18\x01 \x03(\v2\x17.scalehandler.TimeRangeR\n" +
 	"timeRanges\x1a_\n" +
@@ -665,11 +4031,195 @@ const file_common_proto_rawDesc = "" +
 	"\n" +
 	"DatesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x128\n" +
-	"\x05value\x18\x02 \x01(\v2\".scalehandler.Schedule.DayScheduleR\x05value:\x028\x01\"F\n" +
+	"\x05value\x18\x02 \x01(\v2\".scalehandler.Schedule.DayScheduleR\x05value:\x028\x01\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"r\n" +
+	"\tTargetRef\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x1f\n" +
+	"\vapi_version\x18\x04 \x01(\tR\n" +
+	"apiVersion\"\x94\x03\n" +
+	"\x0eScalingOptions\x12/\n" +
+	"\x11min_replica_count\x18\x01 \x01(\x05H\x00R\x0fminReplicaCount\x88\x01\x01\x12/\n" +
+	"\x11max_replica_count\x18\x02 \x01(\x05H\x01R\x0fmaxReplicaCount\x88\x01\x01\x12,\n" +
+	"\x0fcooldown_period\x18\x03 \x01(\x05H\x02R\x0ecooldownPeriod\x88\x01\x01\x12.\n" +
+	"\x10polling_interval\x18\x04 \x01(\x05H\x03R\x0fpollingInterval\x88\x01\x01\x122\n" +
+	"\bfallback\x18\x05 \x01(\v2\x16.scalehandler.FallbackR\bfallback\x129\n" +
+	"\bbehavior\x18\x06 \x01(\v2\x1d.scalehandler.ScalingBehaviorR\bbehaviorB\x14\n" +
+	"\x12_min_replica_countB\x14\n" +
+	"\x12_max_replica_countB\x12\n" +
+	"\x10_cooldown_periodB\x13\n" +
+	"\x11_polling_interval\"S\n" +
+	"\bFallback\x12+\n" +
+	"\x11failure_threshold\x18\x01 \x01(\x05R\x10failureThreshold\x12\x1a\n" +
+	"\breplicas\x18\x02 \x01(\x05R\breplicas\"\x83\x01\n" +
+	"\x0fScalingBehavior\x125\n" +
+	"\bscale_up\x18\x01 \x01(\v2\x1a.scalehandler.ScalingRulesR\ascaleUp\x129\n" +
+	"\n" +
+	"scale_down\x18\x02 \x01(\v2\x1a.scalehandler.ScalingRulesR\tscaleDown\"\xd4\x01\n" +
+	"\fScalingRules\x12E\n" +
+	"\x1cstabilization_window_seconds\x18\x01 \x01(\x05H\x00R\x1astabilizationWindowSeconds\x88\x01\x01\x12#\n" +
+	"\rselect_policy\x18\x02 \x01(\tR\fselectPolicy\x127\n" +
+	"\bpolicies\x18\x03 \x03(\v2\x1b.scalehandler.ScalingPolicyR\bpoliciesB\x1f\n" +
+	"\x1d_stabilization_window_seconds\"`\n" +
+	"\rScalingPolicy\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value\x12%\n" +
+	"\x0eperiod_seconds\x18\x03 \x01(\x05R\rperiodSeconds\"\xac\x02\n" +
+	"\rMetricTrigger\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vmetric_type\x18\x03 \x01(\tR\n" +
+	"metricType\x12E\n" +
+	"\bmetadata\x18\x04 \x03(\v2).scalehandler.MetricTrigger.MetadataEntryR\bmetadata\x12N\n" +
+	"\x12authentication_ref\x18\x05 \x01(\v2\x1f.scalehandler.AuthenticationRefR\x11authenticationRef\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\";\n" +
+	"\x11AuthenticationRef\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\"\xef\x02\n" +
+	"\x0eScheduleStatus\x12\x14\n" +
+	"\x05phase\x18\x01 \x01(\tR\x05phase\x12&\n" +
+	"\x0flast_applied_at\x18\x02 \x01(\tR\rlastAppliedAt\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\x03 \x01(\tR\tlastError\x12-\n" +
+	"\x12applied_generation\x18\x04 \x01(\x03R\x11appliedGeneration\x12\x1e\n" +
+	"\n" +
+	"generation\x18\x05 \x01(\x03R\n" +
+	"generation\x12)\n" +
+	"\x10default_replicas\x18\x06 \x01(\x05R\x0fdefaultReplicas\x12*\n" +
+	"\x11min_replica_count\x18\a \x01(\x05R\x0fminReplicaCount\x12*\n" +
+	"\x11max_replica_count\x18\b \x01(\x05R\x0fmaxReplicaCount\x12.\n" +
+	"\x05pause\x18\t \x01(\v2\x18.scalehandler.PauseStateR\x05pause\"\x8b\x01\n" +
+	"\bManifest\x12\x1f\n" +
+	"\vapi_version\x18\x01 \x01(\tR\n" +
+	"apiVersion\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x04 \x01(\tR\tnamespace\x12\x18\n" +
+	"\acontent\x18\x05 \x01(\tR\acontent\"\xad\x01\n" +
+	"\bOverride\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\breplicas\x18\x02 \x01(\x05R\breplicas\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06active\x18\a \x01(\bR\x06active\"z\n" +
+	"\n" +
+	"PauseState\x12\x1a\n" +
+	"\breplicas\x18\x01 \x01(\x05R\breplicas\x12\x1b\n" +
+	"\tpaused_by\x18\x02 \x01(\tR\bpausedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tpaused_at\x18\x04 \x01(\tR\bpausedAt\"\xf1\a\n" +
 	"\vApplication\x127\n" +
 	"\n" +
 	"containers\x18\x01 \x03(\v2\x17.scalehandler.ContainerR\n" +
-	"containers\"\xc1\x02\n" +
+	"containers\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x123\n" +
+	"\aservice\x18\x03 \x01(\v2\x19.scalehandler.ServiceSpecR\aservice\x123\n" +
+	"\aingress\x18\x04 \x01(\v2\x19.scalehandler.IngressSpecR\aingress\x12.\n" +
+	"\avolumes\x18\x05 \x03(\v2\x14.scalehandler.VolumeR\avolumes\x12P\n" +
+	"\rnode_selector\x18\x06 \x03(\v2+.scalehandler.Application.NodeSelectorEntryR\fnodeSelector\x12:\n" +
+	"\vtolerations\x18\a \x03(\v2\x18.scalehandler.TolerationR\vtolerations\x122\n" +
+	"\baffinity\x18\b \x01(\v2\x16.scalehandler.AffinityR\baffinity\x12f\n" +
+	"\x1btopology_spread_constraints\x18\t \x03(\v2&.scalehandler.TopologySpreadConstraintR\x19topologySpreadConstraints\x12.\n" +
+	"\x13priority_class_name\x18\n" +
+	" \x01(\tR\x11priorityClassName\x12@\n" +
+	"\x0finit_containers\x18\v \x03(\v2\x17.scalehandler.ContainerR\x0einitContainers\x12,\n" +
+	"\x12image_pull_secrets\x18\f \x03(\tR\x10imagePullSecrets\x120\n" +
+	"\x14service_account_name\x18\r \x01(\tR\x12serviceAccountName\x12L\n" +
+	" termination_grace_period_seconds\x18\x0e \x01(\x03H\x00R\x1dterminationGracePeriodSeconds\x88\x01\x01\x12K\n" +
+	"\x10security_context\x18\x0f \x01(\v2 .scalehandler.PodSecurityContextR\x0fsecurityContext\x1a?\n" +
+	"\x11NodeSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B#\n" +
+	"!_termination_grace_period_seconds\"\xee\x01\n" +
+	"\x12PodSecurityContext\x12#\n" +
+	"\vrun_as_user\x18\x01 \x01(\x03H\x00R\trunAsUser\x88\x01\x01\x12%\n" +
+	"\frun_as_group\x18\x02 \x01(\x03H\x01R\n" +
+	"runAsGroup\x88\x01\x01\x12*\n" +
+	"\x0frun_as_non_root\x18\x03 \x01(\bH\x02R\frunAsNonRoot\x88\x01\x01\x12\x1e\n" +
+	"\bfs_group\x18\x04 \x01(\x03H\x03R\afsGroup\x88\x01\x01B\x0e\n" +
+	"\f_run_as_userB\x0f\n" +
+	"\r_run_as_groupB\x12\n" +
+	"\x10_run_as_non_rootB\v\n" +
+	"\t_fs_group\"\xb3\x01\n" +
+	"\n" +
+	"Toleration\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06effect\x18\x04 \x01(\tR\x06effect\x122\n" +
+	"\x12toleration_seconds\x18\x05 \x01(\x03H\x00R\x11tolerationSeconds\x88\x01\x01B\x15\n" +
+	"\x13_toleration_seconds\"\xd0\x01\n" +
+	"\bAffinity\x12?\n" +
+	"\rnode_affinity\x18\x01 \x01(\v2\x1a.scalehandler.NodeAffinityR\fnodeAffinity\x12<\n" +
+	"\fpod_affinity\x18\x02 \x01(\v2\x19.scalehandler.PodAffinityR\vpodAffinity\x12E\n" +
+	"\x11pod_anti_affinity\x18\x03 \x01(\v2\x19.scalehandler.PodAffinityR\x0fpodAntiAffinity\"\x8f\x01\n" +
+	"\fNodeAffinity\x12:\n" +
+	"\brequired\x18\x01 \x03(\v2\x1e.scalehandler.NodeSelectorTermR\brequired\x12C\n" +
+	"\tpreferred\x18\x02 \x03(\v2%.scalehandler.PreferredSchedulingTermR\tpreferred\"b\n" +
+	"\x10NodeSelectorTerm\x12N\n" +
+	"\x11match_expressions\x18\x01 \x03(\v2!.scalehandler.SelectorRequirementR\x10matchExpressions\"q\n" +
+	"\x17PreferredSchedulingTerm\x12\x16\n" +
+	"\x06weight\x18\x01 \x01(\x05R\x06weight\x12>\n" +
+	"\n" +
+	"preference\x18\x02 \x01(\v2\x1e.scalehandler.NodeSelectorTermR\n" +
+	"preference\"[\n" +
+	"\x13SelectorRequirement\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"\x8d\x01\n" +
+	"\vPodAffinity\x129\n" +
+	"\brequired\x18\x01 \x03(\v2\x1d.scalehandler.PodAffinityTermR\brequired\x12C\n" +
+	"\tpreferred\x18\x02 \x03(\v2%.scalehandler.WeightedPodAffinityTermR\tpreferred\"\x98\x01\n" +
+	"\x0fPodAffinityTerm\x12B\n" +
+	"\x0elabel_selector\x18\x01 \x01(\v2\x1b.scalehandler.LabelSelectorR\rlabelSelector\x12!\n" +
+	"\ftopology_key\x18\x02 \x01(\tR\vtopologyKey\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x03 \x03(\tR\n" +
+	"namespaces\"d\n" +
+	"\x17WeightedPodAffinityTerm\x12\x16\n" +
+	"\x06weight\x18\x01 \x01(\x05R\x06weight\x121\n" +
+	"\x04term\x18\x02 \x01(\v2\x1d.scalehandler.PodAffinityTermR\x04term\"\xf0\x01\n" +
+	"\rLabelSelector\x12O\n" +
+	"\fmatch_labels\x18\x01 \x03(\v2,.scalehandler.LabelSelector.MatchLabelsEntryR\vmatchLabels\x12N\n" +
+	"\x11match_expressions\x18\x02 \x03(\v2!.scalehandler.SelectorRequirementR\x10matchExpressions\x1a>\n" +
+	"\x10MatchLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xec\x01\n" +
+	"\x18TopologySpreadConstraint\x12\x19\n" +
+	"\bmax_skew\x18\x01 \x01(\x05R\amaxSkew\x12!\n" +
+	"\ftopology_key\x18\x02 \x01(\tR\vtopologyKey\x12-\n" +
+	"\x12when_unsatisfiable\x18\x03 \x01(\tR\x11whenUnsatisfiable\x12B\n" +
+	"\x0elabel_selector\x18\x04 \x01(\v2\x1b.scalehandler.LabelSelectorR\rlabelSelector\x12\x1f\n" +
+	"\vmin_domains\x18\x05 \x01(\x05R\n" +
+	"minDomains\"R\n" +
+	"\vServiceSpec\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12/\n" +
+	"\x05ports\x18\x02 \x03(\v2\x19.scalehandler.ServicePortR\x05ports\"r\n" +
+	"\vServicePort\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x1f\n" +
+	"\vtarget_port\x18\x03 \x01(\x05R\n" +
+	"targetPort\x12\x1a\n" +
+	"\bprotocol\x18\x04 \x01(\tR\bprotocol\"\xbc\x01\n" +
+	"\vIngressSpec\x12\x1d\n" +
+	"\n" +
+	"class_name\x18\x01 \x01(\tR\tclassName\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x1b\n" +
+	"\tpath_type\x18\x04 \x01(\tR\bpathType\x12!\n" +
+	"\fservice_port\x18\x05 \x01(\x05R\vservicePort\x12&\n" +
+	"\x0ftls_secret_name\x18\x06 \x01(\tR\rtlsSecretName\"\xce\x05\n" +
 	"\tContainer\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05image\x18\x02 \x01(\tR\x05image\x121\n" +
@@ -677,26 +4227,148 @@ const file_common_proto_rawDesc = "" +
 	"\x03env\x18\x04 \x03(\v2\x14.scalehandler.EnvVarR\x03env\x125\n" +
 	"\tresources\x18\x05 \x01(\v2\x17.scalehandler.ResourcesR\tresources\x12:\n" +
 	"\x0eliveness_probe\x18\x06 \x01(\v2\x13.scalehandler.ProbeR\rlivenessProbe\x12<\n" +
-	"\x0freadiness_probe\x18\a \x01(\v2\x13.scalehandler.ProbeR\x0ereadinessProbe\"R\n" +
+	"\x0freadiness_probe\x18\a \x01(\v2\x13.scalehandler.ProbeR\x0ereadinessProbe\x126\n" +
+	"\benv_from\x18\b \x03(\v2\x1b.scalehandler.EnvFromSourceR\aenvFrom\x12>\n" +
+	"\rvolume_mounts\x18\t \x03(\v2\x19.scalehandler.VolumeMountR\fvolumeMounts\x128\n" +
+	"\rstartup_probe\x18\n" +
+	" \x01(\v2\x13.scalehandler.ProbeR\fstartupProbe\x12\x18\n" +
+	"\acommand\x18\v \x03(\tR\acommand\x12\x12\n" +
+	"\x04args\x18\f \x03(\tR\x04args\x12*\n" +
+	"\x11image_pull_policy\x18\r \x01(\tR\x0fimagePullPolicy\x12H\n" +
+	"\x10security_context\x18\x0e \x01(\v2\x1d.scalehandler.SecurityContextR\x0fsecurityContext\x125\n" +
+	"\tlifecycle\x18\x0f \x01(\v2\x17.scalehandler.LifecycleR\tlifecycle\"\xf2\x03\n" +
+	"\x0fSecurityContext\x12#\n" +
+	"\vrun_as_user\x18\x01 \x01(\x03H\x00R\trunAsUser\x88\x01\x01\x12%\n" +
+	"\frun_as_group\x18\x02 \x01(\x03H\x01R\n" +
+	"runAsGroup\x88\x01\x01\x12*\n" +
+	"\x0frun_as_non_root\x18\x03 \x01(\bH\x02R\frunAsNonRoot\x88\x01\x01\x12>\n" +
+	"\x19read_only_root_filesystem\x18\x04 \x01(\bH\x03R\x16readOnlyRootFilesystem\x88\x01\x01\x12A\n" +
+	"\x1aallow_privilege_escalation\x18\x05 \x01(\bH\x04R\x18allowPrivilegeEscalation\x88\x01\x01\x12#\n" +
+	"\n" +
+	"privileged\x18\x06 \x01(\bH\x05R\n" +
+	"privileged\x88\x01\x01\x12>\n" +
+	"\fcapabilities\x18\a \x01(\v2\x1a.scalehandler.CapabilitiesR\fcapabilitiesB\x0e\n" +
+	"\f_run_as_userB\x0f\n" +
+	"\r_run_as_groupB\x12\n" +
+	"\x10_run_as_non_rootB\x1c\n" +
+	"\x1a_read_only_root_filesystemB\x1d\n" +
+	"\x1b_allow_privilege_escalationB\r\n" +
+	"\v_privileged\"4\n" +
+	"\fCapabilities\x12\x10\n" +
+	"\x03add\x18\x01 \x03(\tR\x03add\x12\x12\n" +
+	"\x04drop\x18\x02 \x03(\tR\x04drop\"\x85\x01\n" +
+	"\tLifecycle\x12=\n" +
+	"\n" +
+	"post_start\x18\x01 \x01(\v2\x1e.scalehandler.LifecycleHandlerR\tpostStart\x129\n" +
+	"\bpre_stop\x18\x02 \x01(\v2\x1e.scalehandler.LifecycleHandlerR\apreStop\"x\n" +
+	"\x10LifecycleHandler\x12,\n" +
+	"\x04exec\x18\x01 \x01(\v2\x18.scalehandler.ExecActionR\x04exec\x126\n" +
+	"\bhttp_get\x18\x02 \x01(\v2\x1b.scalehandler.HttpGetActionR\ahttpGet\"R\n" +
 	"\rContainerPort\x12%\n" +
 	"\x0econtainer_port\x18\x01 \x01(\x05R\rcontainerPort\x12\x1a\n" +
-	"\bprotocol\x18\x02 \x01(\tR\bprotocol\"2\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\"m\n" +
 	"\x06EnvVar\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value\"\x7f\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x129\n" +
+	"\n" +
+	"value_from\x18\x03 \x01(\v2\x1a.scalehandler.EnvVarSourceR\tvalueFrom\"\xcc\x01\n" +
+	"\fEnvVarSource\x12?\n" +
+	"\x0esecret_key_ref\x18\x01 \x01(\v2\x19.scalehandler.KeySelectorR\fsecretKeyRef\x12F\n" +
+	"\x12config_map_key_ref\x18\x02 \x01(\v2\x19.scalehandler.KeySelectorR\x0fconfigMapKeyRef\x123\n" +
+	"\tfield_ref\x18\x03 \x01(\v2\x16.scalehandler.FieldRefR\bfieldRef\"O\n" +
+	"\vKeySelector\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1a\n" +
+	"\boptional\x18\x03 \x01(\bR\boptional\")\n" +
+	"\bFieldRef\x12\x1d\n" +
+	"\n" +
+	"field_path\x18\x01 \x01(\tR\tfieldPath\"\xa8\x01\n" +
+	"\rEnvFromSource\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12;\n" +
+	"\n" +
+	"secret_ref\x18\x02 \x01(\v2\x1c.scalehandler.LocalObjectRefR\tsecretRef\x12B\n" +
+	"\x0econfig_map_ref\x18\x03 \x01(\v2\x1c.scalehandler.LocalObjectRefR\fconfigMapRef\"@\n" +
+	"\x0eLocalObjectRef\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\boptional\x18\x02 \x01(\bR\boptional\"\xe6\x02\n" +
+	"\x06Volume\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x129\n" +
+	"\tempty_dir\x18\x02 \x01(\v2\x1c.scalehandler.EmptyDirVolumeR\bemptyDir\x129\n" +
+	"\n" +
+	"config_map\x18\x03 \x01(\v2\x1a.scalehandler.ObjectVolumeR\tconfigMap\x122\n" +
+	"\x06secret\x18\x04 \x01(\v2\x1a.scalehandler.ObjectVolumeR\x06secret\x12a\n" +
+	"\x17persistent_volume_claim\x18\x05 \x01(\v2).scalehandler.PersistentVolumeClaimVolumeR\x15persistentVolumeClaim\x12;\n" +
+	"\tprojected\x18\x06 \x01(\v2\x1d.scalehandler.ProjectedVolumeR\tprojected\"G\n" +
+	"\x0eEmptyDirVolume\x12\x16\n" +
+	"\x06medium\x18\x01 \x01(\tR\x06medium\x12\x1d\n" +
+	"\n" +
+	"size_limit\x18\x02 \x01(\tR\tsizeLimit\"\x90\x01\n" +
+	"\fObjectVolume\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.scalehandler.KeyToPathR\x05items\x12!\n" +
+	"\fdefault_mode\x18\x03 \x01(\x05R\vdefaultMode\x12\x1a\n" +
+	"\boptional\x18\x04 \x01(\bR\boptional\"E\n" +
+	"\tKeyToPath\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x12\n" +
+	"\x04mode\x18\x03 \x01(\x05R\x04mode\"Y\n" +
+	"\x1bPersistentVolumeClaimVolume\x12\x1d\n" +
+	"\n" +
+	"claim_name\x18\x01 \x01(\tR\tclaimName\x12\x1b\n" +
+	"\tread_only\x18\x02 \x01(\bR\breadOnly\"n\n" +
+	"\x0fProjectedVolume\x128\n" +
+	"\asources\x18\x01 \x03(\v2\x1e.scalehandler.VolumeProjectionR\asources\x12!\n" +
+	"\fdefault_mode\x18\x02 \x01(\x05R\vdefaultMode\"\xe2\x01\n" +
+	"\x10VolumeProjection\x129\n" +
+	"\n" +
+	"config_map\x18\x01 \x01(\v2\x1a.scalehandler.ObjectVolumeR\tconfigMap\x122\n" +
+	"\x06secret\x18\x02 \x01(\v2\x1a.scalehandler.ObjectVolumeR\x06secret\x12_\n" +
+	"\x15service_account_token\x18\x03 \x01(\v2+.scalehandler.ServiceAccountTokenProjectionR\x13serviceAccountToken\"~\n" +
+	"\x1dServiceAccountTokenProjection\x12\x1a\n" +
+	"\baudience\x18\x01 \x01(\tR\baudience\x12-\n" +
+	"\x12expiration_seconds\x18\x02 \x01(\x03R\x11expirationSeconds\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\"x\n" +
+	"\vVolumeMount\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"mount_path\x18\x02 \x01(\tR\tmountPath\x12\x19\n" +
+	"\bsub_path\x18\x03 \x01(\tR\asubPath\x12\x1b\n" +
+	"\tread_only\x18\x04 \x01(\bR\breadOnly\"\x7f\n" +
 	"\tResources\x12:\n" +
 	"\brequests\x18\x01 \x01(\v2\x1e.scalehandler.ResourceQuantityR\brequests\x126\n" +
 	"\x06limits\x18\x02 \x01(\v2\x1e.scalehandler.ResourceQuantityR\x06limits\"<\n" +
 	"\x10ResourceQuantity\x12\x16\n" +
 	"\x06memory\x18\x01 \x01(\tR\x06memory\x12\x10\n" +
-	"\x03cpu\x18\x02 \x01(\tR\x03cpu\"\x9a\x01\n" +
+	"\x03cpu\x18\x02 \x01(\tR\x03cpu\"\xb7\x03\n" +
 	"\x05Probe\x126\n" +
 	"\bhttp_get\x18\x01 \x01(\v2\x1b.scalehandler.HttpGetActionR\ahttpGet\x122\n" +
 	"\x15initial_delay_seconds\x18\x02 \x01(\x05R\x13initialDelaySeconds\x12%\n" +
-	"\x0eperiod_seconds\x18\x03 \x01(\x05R\rperiodSeconds\"7\n" +
+	"\x0eperiod_seconds\x18\x03 \x01(\x05R\rperiodSeconds\x12<\n" +
+	"\n" +
+	"tcp_socket\x18\x04 \x01(\v2\x1d.scalehandler.TcpSocketActionR\ttcpSocket\x12,\n" +
+	"\x04exec\x18\x05 \x01(\v2\x18.scalehandler.ExecActionR\x04exec\x12,\n" +
+	"\x04grpc\x18\x06 \x01(\v2\x18.scalehandler.GrpcActionR\x04grpc\x12'\n" +
+	"\x0ftimeout_seconds\x18\a \x01(\x05R\x0etimeoutSeconds\x12+\n" +
+	"\x11failure_threshold\x18\b \x01(\x05R\x10failureThreshold\x12+\n" +
+	"\x11success_threshold\x18\t \x01(\x05R\x10successThreshold\"\x8c\x01\n" +
 	"\rHttpGetAction\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
-	"\x04port\x18\x02 \x01(\x05R\x04portB+Z)proxy-gateway/pkg/api/proto/scale-handlerb\x06proto3"
+	"\x04port\x18\x02 \x01(\x05R\x04port\x12\x16\n" +
+	"\x06scheme\x18\x03 \x01(\tR\x06scheme\x12;\n" +
+	"\fhttp_headers\x18\x04 \x03(\v2\x18.scalehandler.HttpHeaderR\vhttpHeaders\"6\n" +
+	"\n" +
+	"HttpHeader\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"%\n" +
+	"\x0fTcpSocketAction\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\x05R\x04port\"&\n" +
+	"\n" +
+	"ExecAction\x12\x18\n" +
+	"\acommand\x18\x01 \x03(\tR\acommand\":\n" +
+	"\n" +
+	"GrpcAction\x12\x12\n" +
+	"\x04port\x18\x01 \x01(\x05R\x04port\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aserviceB+Z)proxy-gateway/pkg/api/proto/scale-handlerb\x06proto3"
 
 var (
 	file_common_proto_rawDescOnce sync.Once
@@ -710,42 +4382,163 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_common_proto_goTypes = []any{
-	(*TimeRange)(nil),            // 0: scalehandler.TimeRange
-	(*Schedule)(nil),             // 1: scalehandler.Schedule
-	(*Application)(nil),          // 2: scalehandler.Application
-	(*Container)(nil),            // 3: scalehandler.Container
-	(*ContainerPort)(nil),        // 4: scalehandler.ContainerPort
-	(*EnvVar)(nil),               // 5: scalehandler.EnvVar
-	(*Resources)(nil),            // 6: scalehandler.Resources
-	(*ResourceQuantity)(nil),     // 7: scalehandler.ResourceQuantity
-	(*Probe)(nil),                // 8: scalehandler.Probe
-	(*HttpGetAction)(nil),        // 9: scalehandler.HttpGetAction
-	(*Schedule_DaySchedule)(nil), // 10: scalehandler.Schedule.DaySchedule
-	nil,                          // 11: scalehandler.Schedule.WeekdaysEntry
-	nil,                          // 12: scalehandler.Schedule.DatesEntry
+	(*TimeRange)(nil),                     // 0: scalehandler.TimeRange
+	(*Schedule)(nil),                      // 1: scalehandler.Schedule
+	(*TargetRef)(nil),                     // 2: scalehandler.TargetRef
+	(*ScalingOptions)(nil),                // 3: scalehandler.ScalingOptions
+	(*Fallback)(nil),                      // 4: scalehandler.Fallback
+	(*ScalingBehavior)(nil),               // 5: scalehandler.ScalingBehavior
+	(*ScalingRules)(nil),                  // 6: scalehandler.ScalingRules
+	(*ScalingPolicy)(nil),                 // 7: scalehandler.ScalingPolicy
+	(*MetricTrigger)(nil),                 // 8: scalehandler.MetricTrigger
+	(*AuthenticationRef)(nil),             // 9: scalehandler.AuthenticationRef
+	(*ScheduleStatus)(nil),                // 10: scalehandler.ScheduleStatus
+	(*Manifest)(nil),                      // 11: scalehandler.Manifest
+	(*Override)(nil),                      // 12: scalehandler.Override
+	(*PauseState)(nil),                    // 13: scalehandler.PauseState
+	(*Application)(nil),                   // 14: scalehandler.Application
+	(*PodSecurityContext)(nil),            // 15: scalehandler.PodSecurityContext
+	(*Toleration)(nil),                    // 16: scalehandler.Toleration
+	(*Affinity)(nil),                      // 17: scalehandler.Affinity
+	(*NodeAffinity)(nil),                  // 18: scalehandler.NodeAffinity
+	(*NodeSelectorTerm)(nil),              // 19: scalehandler.NodeSelectorTerm
+	(*PreferredSchedulingTerm)(nil),       // 20: scalehandler.PreferredSchedulingTerm
+	(*SelectorRequirement)(nil),           // 21: scalehandler.SelectorRequirement
+	(*PodAffinity)(nil),                   // 22: scalehandler.PodAffinity
+	(*PodAffinityTerm)(nil),               // 23: scalehandler.PodAffinityTerm
+	(*WeightedPodAffinityTerm)(nil),       // 24: scalehandler.WeightedPodAffinityTerm
+	(*LabelSelector)(nil),                 // 25: scalehandler.LabelSelector
+	(*TopologySpreadConstraint)(nil),      // 26: scalehandler.TopologySpreadConstraint
+	(*ServiceSpec)(nil),                   // 27: scalehandler.ServiceSpec
+	(*ServicePort)(nil),                   // 28: scalehandler.ServicePort
+	(*IngressSpec)(nil),                   // 29: scalehandler.IngressSpec
+	(*Container)(nil),                     // 30: scalehandler.Container
+	(*SecurityContext)(nil),               // 31: scalehandler.SecurityContext
+	(*Capabilities)(nil),                  // 32: scalehandler.Capabilities
+	(*Lifecycle)(nil),                     // 33: scalehandler.Lifecycle
+	(*LifecycleHandler)(nil),              // 34: scalehandler.LifecycleHandler
+	(*ContainerPort)(nil),                 // 35: scalehandler.ContainerPort
+	(*EnvVar)(nil),                        // 36: scalehandler.EnvVar
+	(*EnvVarSource)(nil),                  // 37: scalehandler.EnvVarSource
+	(*KeySelector)(nil),                   // 38: scalehandler.KeySelector
+	(*FieldRef)(nil),                      // 39: scalehandler.FieldRef
+	(*EnvFromSource)(nil),                 // 40: scalehandler.EnvFromSource
+	(*LocalObjectRef)(nil),                // 41: scalehandler.LocalObjectRef
+	(*Volume)(nil),                        // 42: scalehandler.Volume
+	(*EmptyDirVolume)(nil),                // 43: scalehandler.EmptyDirVolume
+	(*ObjectVolume)(nil),                  // 44: scalehandler.ObjectVolume
+	(*KeyToPath)(nil),                     // 45: scalehandler.KeyToPath
+	(*PersistentVolumeClaimVolume)(nil),   // 46: scalehandler.PersistentVolumeClaimVolume
+	(*ProjectedVolume)(nil),               // 47: scalehandler.ProjectedVolume
+	(*VolumeProjection)(nil),              // 48: scalehandler.VolumeProjection
+	(*ServiceAccountTokenProjection)(nil), // 49: scalehandler.ServiceAccountTokenProjection
+	(*VolumeMount)(nil),                   // 50: scalehandler.VolumeMount
+	(*Resources)(nil),                     // 51: scalehandler.Resources
+	(*ResourceQuantity)(nil),              // 52: scalehandler.ResourceQuantity
+	(*Probe)(nil),                         // 53: scalehandler.Probe
+	(*HttpGetAction)(nil),                 // 54: scalehandler.HttpGetAction
+	(*HttpHeader)(nil),                    // 55: scalehandler.HttpHeader
+	(*TcpSocketAction)(nil),               // 56: scalehandler.TcpSocketAction
+	(*ExecAction)(nil),                    // 57: scalehandler.ExecAction
+	(*GrpcAction)(nil),                    // 58: scalehandler.GrpcAction
+	(*Schedule_DaySchedule)(nil),          // 59: scalehandler.Schedule.DaySchedule
+	nil,                                   // 60: scalehandler.Schedule.WeekdaysEntry
+	nil,                                   // 61: scalehandler.Schedule.DatesEntry
+	nil,                                   // 62: scalehandler.Schedule.LabelsEntry
+	nil,                                   // 63: scalehandler.Schedule.AnnotationsEntry
+	nil,                                   // 64: scalehandler.MetricTrigger.MetadataEntry
+	nil,                                   // 65: scalehandler.Application.NodeSelectorEntry
+	nil,                                   // 66: scalehandler.LabelSelector.MatchLabelsEntry
 }
 var file_common_proto_depIdxs = []int32{
-	11, // 0: scalehandler.Schedule.weekdays:type_name -> scalehandler.Schedule.WeekdaysEntry
-	12, // 1: scalehandler.Schedule.dates:type_name -> scalehandler.Schedule.DatesEntry
-	3,  // 2: scalehandler.Application.containers:type_name -> scalehandler.Container
-	4,  // 3: scalehandler.Container.ports:type_name -> scalehandler.ContainerPort
-	5,  // 4: scalehandler.Container.env:type_name -> scalehandler.EnvVar
-	6,  // 5: scalehandler.Container.resources:type_name -> scalehandler.Resources
-	8,  // 6: scalehandler.Container.liveness_probe:type_name -> scalehandler.Probe
-	8,  // 7: scalehandler.Container.readiness_probe:type_name -> scalehandler.Probe
-	7,  // 8: scalehandler.Resources.requests:type_name -> scalehandler.ResourceQuantity
-	7,  // 9: scalehandler.Resources.limits:type_name -> scalehandler.ResourceQuantity
-	9,  // 10: scalehandler.Probe.http_get:type_name -> scalehandler.HttpGetAction
-	0,  // 11: scalehandler.Schedule.DaySchedule.time_ranges:type_name -> scalehandler.TimeRange
-	10, // 12: scalehandler.Schedule.WeekdaysEntry.value:type_name -> scalehandler.Schedule.DaySchedule
-	10, // 13: scalehandler.Schedule.DatesEntry.value:type_name -> scalehandler.Schedule.DaySchedule
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	60, // 0: scalehandler.Schedule.weekdays:type_name -> scalehandler.Schedule.WeekdaysEntry
+	61, // 1: scalehandler.Schedule.dates:type_name -> scalehandler.Schedule.DatesEntry
+	3,  // 2: scalehandler.Schedule.scaling:type_name -> scalehandler.ScalingOptions
+	2,  // 3: scalehandler.Schedule.target:type_name -> scalehandler.TargetRef
+	62, // 4: scalehandler.Schedule.labels:type_name -> scalehandler.Schedule.LabelsEntry
+	63, // 5: scalehandler.Schedule.annotations:type_name -> scalehandler.Schedule.AnnotationsEntry
+	8,  // 6: scalehandler.Schedule.triggers:type_name -> scalehandler.MetricTrigger
+	4,  // 7: scalehandler.ScalingOptions.fallback:type_name -> scalehandler.Fallback
+	5,  // 8: scalehandler.ScalingOptions.behavior:type_name -> scalehandler.ScalingBehavior
+	6,  // 9: scalehandler.ScalingBehavior.scale_up:type_name -> scalehandler.ScalingRules
+	6,  // 10: scalehandler.ScalingBehavior.scale_down:type_name -> scalehandler.ScalingRules
+	7,  // 11: scalehandler.ScalingRules.policies:type_name -> scalehandler.ScalingPolicy
+	64, // 12: scalehandler.MetricTrigger.metadata:type_name -> scalehandler.MetricTrigger.MetadataEntry
+	9,  // 13: scalehandler.MetricTrigger.authentication_ref:type_name -> scalehandler.AuthenticationRef
+	13, // 14: scalehandler.ScheduleStatus.pause:type_name -> scalehandler.PauseState
+	30, // 15: scalehandler.Application.containers:type_name -> scalehandler.Container
+	27, // 16: scalehandler.Application.service:type_name -> scalehandler.ServiceSpec
+	29, // 17: scalehandler.Application.ingress:type_name -> scalehandler.IngressSpec
+	42, // 18: scalehandler.Application.volumes:type_name -> scalehandler.Volume
+	65, // 19: scalehandler.Application.node_selector:type_name -> scalehandler.Application.NodeSelectorEntry
+	16, // 20: scalehandler.Application.tolerations:type_name -> scalehandler.Toleration
+	17, // 21: scalehandler.Application.affinity:type_name -> scalehandler.Affinity
+	26, // 22: scalehandler.Application.topology_spread_constraints:type_name -> scalehandler.TopologySpreadConstraint
+	30, // 23: scalehandler.Application.init_containers:type_name -> scalehandler.Container
+	15, // 24: scalehandler.Application.security_context:type_name -> scalehandler.PodSecurityContext
+	18, // 25: scalehandler.Affinity.node_affinity:type_name -> scalehandler.NodeAffinity
+	22, // 26: scalehandler.Affinity.pod_affinity:type_name -> scalehandler.PodAffinity
+	22, // 27: scalehandler.Affinity.pod_anti_affinity:type_name -> scalehandler.PodAffinity
+	19, // 28: scalehandler.NodeAffinity.required:type_name -> scalehandler.NodeSelectorTerm
+	20, // 29: scalehandler.NodeAffinity.preferred:type_name -> scalehandler.PreferredSchedulingTerm
+	21, // 30: scalehandler.NodeSelectorTerm.match_expressions:type_name -> scalehandler.SelectorRequirement
+	19, // 31: scalehandler.PreferredSchedulingTerm.preference:type_name -> scalehandler.NodeSelectorTerm
+	23, // 32: scalehandler.PodAffinity.required:type_name -> scalehandler.PodAffinityTerm
+	24, // 33: scalehandler.PodAffinity.preferred:type_name -> scalehandler.WeightedPodAffinityTerm
+	25, // 34: scalehandler.PodAffinityTerm.label_selector:type_name -> scalehandler.LabelSelector
+	23, // 35: scalehandler.WeightedPodAffinityTerm.term:type_name -> scalehandler.PodAffinityTerm
+	66, // 36: scalehandler.LabelSelector.match_labels:type_name -> scalehandler.LabelSelector.MatchLabelsEntry
+	21, // 37: scalehandler.LabelSelector.match_expressions:type_name -> scalehandler.SelectorRequirement
+	25, // 38: scalehandler.TopologySpreadConstraint.label_selector:type_name -> scalehandler.LabelSelector
+	28, // 39: scalehandler.ServiceSpec.ports:type_name -> scalehandler.ServicePort
+	35, // 40: scalehandler.Container.ports:type_name -> scalehandler.ContainerPort
+	36, // 41: scalehandler.Container.env:type_name -> scalehandler.EnvVar
+	51, // 42: scalehandler.Container.resources:type_name -> scalehandler.Resources
+	53, // 43: scalehandler.Container.liveness_probe:type_name -> scalehandler.Probe
+	53, // 44: scalehandler.Container.readiness_probe:type_name -> scalehandler.Probe
+	40, // 45: scalehandler.Container.env_from:type_name -> scalehandler.EnvFromSource
+	50, // 46: scalehandler.Container.volume_mounts:type_name -> scalehandler.VolumeMount
+	53, // 47: scalehandler.Container.startup_probe:type_name -> scalehandler.Probe
+	31, // 48: scalehandler.Container.security_context:type_name -> scalehandler.SecurityContext
+	33, // 49: scalehandler.Container.lifecycle:type_name -> scalehandler.Lifecycle
+	32, // 50: scalehandler.SecurityContext.capabilities:type_name -> scalehandler.Capabilities
+	34, // 51: scalehandler.Lifecycle.post_start:type_name -> scalehandler.LifecycleHandler
+	34, // 52: scalehandler.Lifecycle.pre_stop:type_name -> scalehandler.LifecycleHandler
+	57, // 53: scalehandler.LifecycleHandler.exec:type_name -> scalehandler.ExecAction
+	54, // 54: scalehandler.LifecycleHandler.http_get:type_name -> scalehandler.HttpGetAction
+	37, // 55: scalehandler.EnvVar.value_from:type_name -> scalehandler.EnvVarSource
+	38, // 56: scalehandler.EnvVarSource.secret_key_ref:type_name -> scalehandler.KeySelector
+	38, // 57: scalehandler.EnvVarSource.config_map_key_ref:type_name -> scalehandler.KeySelector
+	39, // 58: scalehandler.EnvVarSource.field_ref:type_name -> scalehandler.FieldRef
+	41, // 59: scalehandler.EnvFromSource.secret_ref:type_name -> scalehandler.LocalObjectRef
+	41, // 60: scalehandler.EnvFromSource.config_map_ref:type_name -> scalehandler.LocalObjectRef
+	43, // 61: scalehandler.Volume.empty_dir:type_name -> scalehandler.EmptyDirVolume
+	44, // 62: scalehandler.Volume.config_map:type_name -> scalehandler.ObjectVolume
+	44, // 63: scalehandler.Volume.secret:type_name -> scalehandler.ObjectVolume
+	46, // 64: scalehandler.Volume.persistent_volume_claim:type_name -> scalehandler.PersistentVolumeClaimVolume
+	47, // 65: scalehandler.Volume.projected:type_name -> scalehandler.ProjectedVolume
+	45, // 66: scalehandler.ObjectVolume.items:type_name -> scalehandler.KeyToPath
+	48, // 67: scalehandler.ProjectedVolume.sources:type_name -> scalehandler.VolumeProjection
+	44, // 68: scalehandler.VolumeProjection.config_map:type_name -> scalehandler.ObjectVolume
+	44, // 69: scalehandler.VolumeProjection.secret:type_name -> scalehandler.ObjectVolume
+	49, // 70: scalehandler.VolumeProjection.service_account_token:type_name -> scalehandler.ServiceAccountTokenProjection
+	52, // 71: scalehandler.Resources.requests:type_name -> scalehandler.ResourceQuantity
+	52, // 72: scalehandler.Resources.limits:type_name -> scalehandler.ResourceQuantity
+	54, // 73: scalehandler.Probe.http_get:type_name -> scalehandler.HttpGetAction
+	56, // 74: scalehandler.Probe.tcp_socket:type_name -> scalehandler.TcpSocketAction
+	57, // 75: scalehandler.Probe.exec:type_name -> scalehandler.ExecAction
+	58, // 76: scalehandler.Probe.grpc:type_name -> scalehandler.GrpcAction
+	55, // 77: scalehandler.HttpGetAction.http_headers:type_name -> scalehandler.HttpHeader
+	0,  // 78: scalehandler.Schedule.DaySchedule.time_ranges:type_name -> scalehandler.TimeRange
+	59, // 79: scalehandler.Schedule.WeekdaysEntry.value:type_name -> scalehandler.Schedule.DaySchedule
+	59, // 80: scalehandler.Schedule.DatesEntry.value:type_name -> scalehandler.Schedule.DaySchedule
+	81, // [81:81] is the sub-list for method output_type
+	81, // [81:81] is the sub-list for method input_type
+	81, // [81:81] is the sub-list for extension type_name
+	81, // [81:81] is the sub-list for extension extendee
+	0,  // [0:81] is the sub-list for field type_name
 }
 
 func init() { file_common_proto_init() }
@@ -753,13 +4546,19 @@ func file_common_proto_init() {
 	if File_common_proto != nil {
 		return
 	}
+	file_common_proto_msgTypes[3].OneofWrappers = []any{}
+	file_common_proto_msgTypes[6].OneofWrappers = []any{}
+	file_common_proto_msgTypes[14].OneofWrappers = []any{}
+	file_common_proto_msgTypes[15].OneofWrappers = []any{}
+	file_common_proto_msgTypes[16].OneofWrappers = []any{}
+	file_common_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -25,6 +25,8 @@ type CreateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	Application   *Application           `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // проверить через server-side dry-run, ничего не сохраняя
+	Format        string                 `protobuf:"bytes,4,opt,name=format,proto3" json:"format,omitempty"`                // формат manifests при dry_run: json (по умолчанию) или yaml
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,9 +75,24 @@ func (x *CreateRequest) GetApplication() *Application {
 	return nil
 }
 
+func (x *CreateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *CreateRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
 type CreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // пусто при dry_run
+	Manifests     []*Manifest            `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -117,11 +134,20 @@ func (x *CreateResponse) GetId() string {
 	return ""
 }
 
+func (x *CreateResponse) GetManifests() []*Manifest {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
 type UpdateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Schedule      *Schedule              `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	Application   *Application           `protobuf:"bytes,3,opt,name=application,proto3" json:"application,omitempty"`
+	DryRun        bool                   `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Format        string                 `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -177,9 +203,24 @@ func (x *UpdateRequest) GetApplication() *Application {
 	return nil
 }
 
+func (x *UpdateRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *UpdateRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
 type UpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Manifests     []*Manifest            `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -221,6 +262,13 @@ func (x *UpdateResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *UpdateResponse) GetManifests() []*Manifest {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
 type GetRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -269,6 +317,7 @@ type GetResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	Application   *Application           `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"`
+	Status        *ScheduleStatus        `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -317,6 +366,13 @@ func (x *GetResponse) GetApplication() *Application {
 	return nil
 }
 
+func (x *GetResponse) GetStatus() *ScheduleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
 type ListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -357,6 +413,8 @@ type ScheduleWithApplication struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Schedule      *Schedule              `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	Application   *Application           `protobuf:"bytes,2,opt,name=application,proto3" json:"application,omitempty"`
+	Status        *ScheduleStatus        `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -405,6 +463,20 @@ func (x *ScheduleWithApplication) GetApplication() *Application {
 	return nil
 }
 
+func (x *ScheduleWithApplication) GetStatus() *ScheduleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ScheduleWithApplication) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
 type ListResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Items         []*ScheduleWithApplication `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -537,38 +609,888 @@ func (x *DeleteResponse) GetSuccess() bool {
 	return false
 }
 
+type RenderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"` // json (по умолчанию) или yaml
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderRequest) Reset() {
+	*x = RenderRequest{}
+	mi := &file_contracts_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderRequest) ProtoMessage() {}
+
+func (x *RenderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderRequest.ProtoReflect.Descriptor instead.
+func (*RenderRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RenderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RenderRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type RenderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Manifests     []*Manifest            `protobuf:"bytes,1,rep,name=manifests,proto3" json:"manifests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenderResponse) Reset() {
+	*x = RenderResponse{}
+	mi := &file_contracts_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderResponse) ProtoMessage() {}
+
+func (x *RenderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderResponse.ProtoReflect.Descriptor instead.
+func (*RenderResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RenderResponse) GetManifests() []*Manifest {
+	if x != nil {
+		return x.Manifests
+	}
+	return nil
+}
+
+type PauseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Replicas      *int32                 `protobuf:"varint,2,opt,name=replicas,proto3,oneof" json:"replicas,omitempty"` // пусто = текущее число реплик workload
+	PausedBy      string                 `protobuf:"bytes,3,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseRequest) Reset() {
+	*x = PauseRequest{}
+	mi := &file_contracts_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseRequest) ProtoMessage() {}
+
+func (x *PauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseRequest.ProtoReflect.Descriptor instead.
+func (*PauseRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PauseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PauseRequest) GetReplicas() int32 {
+	if x != nil && x.Replicas != nil {
+		return *x.Replicas
+	}
+	return 0
+}
+
+func (x *PauseRequest) GetPausedBy() string {
+	if x != nil {
+		return x.PausedBy
+	}
+	return ""
+}
+
+func (x *PauseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PauseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *ScheduleStatus        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseResponse) Reset() {
+	*x = PauseResponse{}
+	mi := &file_contracts_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseResponse) ProtoMessage() {}
+
+func (x *PauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseResponse.ProtoReflect.Descriptor instead.
+func (*PauseResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PauseResponse) GetStatus() *ScheduleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type ResumeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeRequest) Reset() {
+	*x = ResumeRequest{}
+	mi := &file_contracts_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeRequest) ProtoMessage() {}
+
+func (x *ResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeRequest.ProtoReflect.Descriptor instead.
+func (*ResumeRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ResumeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ResumeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        *ScheduleStatus        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeResponse) Reset() {
+	*x = ResumeResponse{}
+	mi := &file_contracts_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeResponse) ProtoMessage() {}
+
+func (x *ResumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeResponse.ProtoReflect.Descriptor instead.
+func (*ResumeResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ResumeResponse) GetStatus() *ScheduleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type CreateOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Replicas      int32                  `protobuf:"varint,2,opt,name=replicas,proto3" json:"replicas,omitempty"` // не ниже действующего minReplicaCount расписания
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`        // RFC 3339, пусто = сейчас
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`            // RFC 3339
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOverrideRequest) Reset() {
+	*x = CreateOverrideRequest{}
+	mi := &file_contracts_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOverrideRequest) ProtoMessage() {}
+
+func (x *CreateOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOverrideRequest.ProtoReflect.Descriptor instead.
+func (*CreateOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CreateOverrideRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *CreateOverrideRequest) GetReplicas() int32 {
+	if x != nil {
+		return x.Replicas
+	}
+	return 0
+}
+
+func (x *CreateOverrideRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *CreateOverrideRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *CreateOverrideRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Override      *Override              `protobuf:"bytes,1,opt,name=override,proto3" json:"override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateOverrideResponse) Reset() {
+	*x = CreateOverrideResponse{}
+	mi := &file_contracts_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOverrideResponse) ProtoMessage() {}
+
+func (x *CreateOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOverrideResponse.ProtoReflect.Descriptor instead.
+func (*CreateOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateOverrideResponse) GetOverride() *Override {
+	if x != nil {
+		return x.Override
+	}
+	return nil
+}
+
+type ListOverridesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOverridesRequest) Reset() {
+	*x = ListOverridesRequest{}
+	mi := &file_contracts_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOverridesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOverridesRequest) ProtoMessage() {}
+
+func (x *ListOverridesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOverridesRequest.ProtoReflect.Descriptor instead.
+func (*ListOverridesRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListOverridesRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+type ListOverridesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*Override            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOverridesResponse) Reset() {
+	*x = ListOverridesResponse{}
+	mi := &file_contracts_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOverridesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOverridesResponse) ProtoMessage() {}
+
+func (x *ListOverridesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOverridesResponse.ProtoReflect.Descriptor instead.
+func (*ListOverridesResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListOverridesResponse) GetItems() []*Override {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type CancelOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOverrideRequest) Reset() {
+	*x = CancelOverrideRequest{}
+	mi := &file_contracts_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOverrideRequest) ProtoMessage() {}
+
+func (x *CancelOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOverrideRequest.ProtoReflect.Descriptor instead.
+func (*CancelOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CancelOverrideRequest) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *CancelOverrideRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelOverrideResponse) Reset() {
+	*x = CancelOverrideResponse{}
+	mi := &file_contracts_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelOverrideResponse) ProtoMessage() {}
+
+func (x *CancelOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelOverrideResponse.ProtoReflect.Descriptor instead.
+func (*CancelOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CancelOverrideResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CollectGarbageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // только найти, ничего не помечать и не удалять
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectGarbageRequest) Reset() {
+	*x = CollectGarbageRequest{}
+	mi := &file_contracts_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectGarbageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectGarbageRequest) ProtoMessage() {}
+
+func (x *CollectGarbageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectGarbageRequest.ProtoReflect.Descriptor instead.
+func (*CollectGarbageRequest) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CollectGarbageRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// OrphanedResource - объект с меткой сервиса, для которого нет расписания
+type OrphanedResource struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleId    string                 `protobuf:"bytes,1,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	OrphanedAt    string                 `protobuf:"bytes,5,opt,name=orphaned_at,json=orphanedAt,proto3" json:"orphaned_at,omitempty"` // RFC 3339, пусто = ещё не помечен
+	Action        string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`                           // marked, deleted или пусто
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrphanedResource) Reset() {
+	*x = OrphanedResource{}
+	mi := &file_contracts_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrphanedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrphanedResource) ProtoMessage() {}
+
+func (x *OrphanedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrphanedResource.ProtoReflect.Descriptor instead.
+func (*OrphanedResource) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OrphanedResource) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *OrphanedResource) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OrphanedResource) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *OrphanedResource) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OrphanedResource) GetOrphanedAt() string {
+	if x != nil {
+		return x.OrphanedAt
+	}
+	return ""
+}
+
+func (x *OrphanedResource) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+type CollectGarbageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checked       int32                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Orphans       []*OrphanedResource    `protobuf:"bytes,2,rep,name=orphans,proto3" json:"orphans,omitempty"`
+	Errors        []string               `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectGarbageResponse) Reset() {
+	*x = CollectGarbageResponse{}
+	mi := &file_contracts_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectGarbageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectGarbageResponse) ProtoMessage() {}
+
+func (x *CollectGarbageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_contracts_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectGarbageResponse.ProtoReflect.Descriptor instead.
+func (*CollectGarbageResponse) Descriptor() ([]byte, []int) {
+	return file_contracts_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CollectGarbageResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *CollectGarbageResponse) GetOrphans() []*OrphanedResource {
+	if x != nil {
+		return x.Orphans
+	}
+	return nil
+}
+
+func (x *CollectGarbageResponse) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
 var File_contracts_proto protoreflect.FileDescriptor
 
 const file_contracts_proto_rawDesc = "" +
 	"\n" +
-	"\x0fcontracts.proto\x12\fscalehandler\x1a\fcommon.proto\"\x80\x01\n" +
+	"\x0fcontracts.proto\x12\fscalehandler\x1a\fcommon.proto\"\xb1\x01\n" +
 	"\rCreateRequest\x122\n" +
 	"\bschedule\x18\x01 \x01(\v2\x16.scalehandler.ScheduleR\bschedule\x12;\n" +
-	"\vapplication\x18\x02 \x01(\v2\x19.scalehandler.ApplicationR\vapplication\" \n" +
+	"\vapplication\x18\x02 \x01(\v2\x19.scalehandler.ApplicationR\vapplication\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x16\n" +
+	"\x06format\x18\x04 \x01(\tR\x06format\"V\n" +
 	"\x0eCreateResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x90\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\tmanifests\x18\x02 \x03(\v2\x16.scalehandler.ManifestR\tmanifests\"\xc1\x01\n" +
 	"\rUpdateRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x122\n" +
 	"\bschedule\x18\x02 \x01(\v2\x16.scalehandler.ScheduleR\bschedule\x12;\n" +
-	"\vapplication\x18\x03 \x01(\v2\x19.scalehandler.ApplicationR\vapplication\"*\n" +
+	"\vapplication\x18\x03 \x01(\v2\x19.scalehandler.ApplicationR\vapplication\x12\x17\n" +
+	"\adry_run\x18\x04 \x01(\bR\x06dryRun\x12\x16\n" +
+	"\x06format\x18\x05 \x01(\tR\x06format\"`\n" +
 	"\x0eUpdateResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1c\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x124\n" +
+	"\tmanifests\x18\x02 \x03(\v2\x16.scalehandler.ManifestR\tmanifests\"\x1c\n" +
 	"\n" +
 	"GetRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"~\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb4\x01\n" +
 	"\vGetResponse\x122\n" +
 	"\bschedule\x18\x01 \x01(\v2\x16.scalehandler.ScheduleR\bschedule\x12;\n" +
-	"\vapplication\x18\x02 \x01(\v2\x19.scalehandler.ApplicationR\vapplication\"\r\n" +
-	"\vListRequest\"\x8a\x01\n" +
+	"\vapplication\x18\x02 \x01(\v2\x19.scalehandler.ApplicationR\vapplication\x124\n" +
+	"\x06status\x18\x03 \x01(\v2\x1c.scalehandler.ScheduleStatusR\x06status\"\r\n" +
+	"\vListRequest\"\xd0\x01\n" +
 	"\x17ScheduleWithApplication\x122\n" +
 	"\bschedule\x18\x01 \x01(\v2\x16.scalehandler.ScheduleR\bschedule\x12;\n" +
-	"\vapplication\x18\x02 \x01(\v2\x19.scalehandler.ApplicationR\vapplication\"K\n" +
+	"\vapplication\x18\x02 \x01(\v2\x19.scalehandler.ApplicationR\vapplication\x124\n" +
+	"\x06status\x18\x03 \x01(\v2\x1c.scalehandler.ScheduleStatusR\x06status\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\"K\n" +
 	"\fListResponse\x12;\n" +
 	"\x05items\x18\x01 \x03(\v2%.scalehandler.ScheduleWithApplicationR\x05items\"\x1f\n" +
 	"\rDeleteRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"*\n" +
 	"\x0eDeleteResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccessB+Z)proxy-gateway/pkg/api/proto/scale-handlerb\x06proto3"
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"7\n" +
+	"\rRenderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\"F\n" +
+	"\x0eRenderResponse\x124\n" +
+	"\tmanifests\x18\x01 \x03(\v2\x16.scalehandler.ManifestR\tmanifests\"\x81\x01\n" +
+	"\fPauseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\breplicas\x18\x02 \x01(\x05H\x00R\breplicas\x88\x01\x01\x12\x1b\n" +
+	"\tpaused_by\x18\x03 \x01(\tR\bpausedBy\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reasonB\v\n" +
+	"\t_replicas\"E\n" +
+	"\rPauseResponse\x124\n" +
+	"\x06status\x18\x01 \x01(\v2\x1c.scalehandler.ScheduleStatusR\x06status\"\x1f\n" +
+	"\rResumeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"F\n" +
+	"\x0eResumeResponse\x124\n" +
+	"\x06status\x18\x01 \x01(\v2\x1c.scalehandler.ScheduleStatusR\x06status\"\x94\x01\n" +
+	"\x15CreateOverrideRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x1a\n" +
+	"\breplicas\x18\x02 \x01(\x05R\breplicas\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"L\n" +
+	"\x16CreateOverrideResponse\x122\n" +
+	"\boverride\x18\x01 \x01(\v2\x16.scalehandler.OverrideR\boverride\"7\n" +
+	"\x14ListOverridesRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\"E\n" +
+	"\x15ListOverridesResponse\x12,\n" +
+	"\x05items\x18\x01 \x03(\v2\x16.scalehandler.OverrideR\x05items\"H\n" +
+	"\x15CancelOverrideRequest\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"2\n" +
+	"\x16CancelOverrideResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"0\n" +
+	"\x15CollectGarbageRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"\xb2\x01\n" +
+	"\x10OrphanedResource\x12\x1f\n" +
+	"\vschedule_id\x18\x01 \x01(\tR\n" +
+	"scheduleId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1f\n" +
+	"\vorphaned_at\x18\x05 \x01(\tR\n" +
+	"orphanedAt\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\"\x84\x01\n" +
+	"\x16CollectGarbageResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x128\n" +
+	"\aorphans\x18\x02 \x03(\v2\x1e.scalehandler.OrphanedResourceR\aorphans\x12\x16\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errorsB+Z)proxy-gateway/pkg/api/proto/scale-handlerb\x06proto3"
 
 var (
 	file_contracts_proto_rawDescOnce sync.Once
@@ -582,7 +1504,7 @@ func file_contracts_proto_rawDescGZIP() []byte {
 	return file_contracts_proto_rawDescData
 }
 
-var file_contracts_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_contracts_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_contracts_proto_goTypes = []any{
 	(*CreateRequest)(nil),           // 0: scalehandler.CreateRequest
 	(*CreateResponse)(nil),          // 1: scalehandler.CreateResponse
@@ -595,24 +1517,52 @@ var file_contracts_proto_goTypes = []any{
 	(*ListResponse)(nil),            // 8: scalehandler.ListResponse
 	(*DeleteRequest)(nil),           // 9: scalehandler.DeleteRequest
 	(*DeleteResponse)(nil),          // 10: scalehandler.DeleteResponse
-	(*Schedule)(nil),                // 11: scalehandler.Schedule
-	(*Application)(nil),             // 12: scalehandler.Application
+	(*RenderRequest)(nil),           // 11: scalehandler.RenderRequest
+	(*RenderResponse)(nil),          // 12: scalehandler.RenderResponse
+	(*PauseRequest)(nil),            // 13: scalehandler.PauseRequest
+	(*PauseResponse)(nil),           // 14: scalehandler.PauseResponse
+	(*ResumeRequest)(nil),           // 15: scalehandler.ResumeRequest
+	(*ResumeResponse)(nil),          // 16: scalehandler.ResumeResponse
+	(*CreateOverrideRequest)(nil),   // 17: scalehandler.CreateOverrideRequest
+	(*CreateOverrideResponse)(nil),  // 18: scalehandler.CreateOverrideResponse
+	(*ListOverridesRequest)(nil),    // 19: scalehandler.ListOverridesRequest
+	(*ListOverridesResponse)(nil),   // 20: scalehandler.ListOverridesResponse
+	(*CancelOverrideRequest)(nil),   // 21: scalehandler.CancelOverrideRequest
+	(*CancelOverrideResponse)(nil),  // 22: scalehandler.CancelOverrideResponse
+	(*CollectGarbageRequest)(nil),   // 23: scalehandler.CollectGarbageRequest
+	(*OrphanedResource)(nil),        // 24: scalehandler.OrphanedResource
+	(*CollectGarbageResponse)(nil),  // 25: scalehandler.CollectGarbageResponse
+	(*Schedule)(nil),                // 26: scalehandler.Schedule
+	(*Application)(nil),             // 27: scalehandler.Application
+	(*Manifest)(nil),                // 28: scalehandler.Manifest
+	(*ScheduleStatus)(nil),          // 29: scalehandler.ScheduleStatus
+	(*Override)(nil),                // 30: scalehandler.Override
 }
 var file_contracts_proto_depIdxs = []int32{
-	11, // 0: scalehandler.CreateRequest.schedule:type_name -> scalehandler.Schedule
-	12, // 1: scalehandler.CreateRequest.application:type_name -> scalehandler.Application
-	11, // 2: scalehandler.UpdateRequest.schedule:type_name -> scalehandler.Schedule
-	12, // 3: scalehandler.UpdateRequest.application:type_name -> scalehandler.Application
-	11, // 4: scalehandler.GetResponse.schedule:type_name -> scalehandler.Schedule
-	12, // 5: scalehandler.GetResponse.application:type_name -> scalehandler.Application
-	11, // 6: scalehandler.ScheduleWithApplication.schedule:type_name -> scalehandler.Schedule
-	12, // 7: scalehandler.ScheduleWithApplication.application:type_name -> scalehandler.Application
-	7,  // 8: scalehandler.ListResponse.items:type_name -> scalehandler.ScheduleWithApplication
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	26, // 0: scalehandler.CreateRequest.schedule:type_name -> scalehandler.Schedule
+	27, // 1: scalehandler.CreateRequest.application:type_name -> scalehandler.Application
+	28, // 2: scalehandler.CreateResponse.manifests:type_name -> scalehandler.Manifest
+	26, // 3: scalehandler.UpdateRequest.schedule:type_name -> scalehandler.Schedule
+	27, // 4: scalehandler.UpdateRequest.application:type_name -> scalehandler.Application
+	28, // 5: scalehandler.UpdateResponse.manifests:type_name -> scalehandler.Manifest
+	26, // 6: scalehandler.GetResponse.schedule:type_name -> scalehandler.Schedule
+	27, // 7: scalehandler.GetResponse.application:type_name -> scalehandler.Application
+	29, // 8: scalehandler.GetResponse.status:type_name -> scalehandler.ScheduleStatus
+	26, // 9: scalehandler.ScheduleWithApplication.schedule:type_name -> scalehandler.Schedule
+	27, // 10: scalehandler.ScheduleWithApplication.application:type_name -> scalehandler.Application
+	29, // 11: scalehandler.ScheduleWithApplication.status:type_name -> scalehandler.ScheduleStatus
+	7,  // 12: scalehandler.ListResponse.items:type_name -> scalehandler.ScheduleWithApplication
+	28, // 13: scalehandler.RenderResponse.manifests:type_name -> scalehandler.Manifest
+	29, // 14: scalehandler.PauseResponse.status:type_name -> scalehandler.ScheduleStatus
+	29, // 15: scalehandler.ResumeResponse.status:type_name -> scalehandler.ScheduleStatus
+	30, // 16: scalehandler.CreateOverrideResponse.override:type_name -> scalehandler.Override
+	30, // 17: scalehandler.ListOverridesResponse.items:type_name -> scalehandler.Override
+	24, // 18: scalehandler.CollectGarbageResponse.orphans:type_name -> scalehandler.OrphanedResource
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_contracts_proto_init() }
@@ -621,13 +1571,14 @@ func file_contracts_proto_init() {
 		return
 	}
 	file_common_proto_init()
+	file_contracts_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_contracts_proto_rawDesc), len(file_contracts_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -19,11 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ScaleHandlerService_Create_FullMethodName = "/scalehandler.ScaleHandlerService/Create"
-	ScaleHandlerService_List_FullMethodName   = "/scalehandler.ScaleHandlerService/List"
-	ScaleHandlerService_Get_FullMethodName    = "/scalehandler.ScaleHandlerService/Get"
-	ScaleHandlerService_Update_FullMethodName = "/scalehandler.ScaleHandlerService/Update"
-	ScaleHandlerService_Delete_FullMethodName = "/scalehandler.ScaleHandlerService/Delete"
+	ScaleHandlerService_Create_FullMethodName         = "/scalehandler.ScaleHandlerService/Create"
+	ScaleHandlerService_List_FullMethodName           = "/scalehandler.ScaleHandlerService/List"
+	ScaleHandlerService_Get_FullMethodName            = "/scalehandler.ScaleHandlerService/Get"
+	ScaleHandlerService_Update_FullMethodName         = "/scalehandler.ScaleHandlerService/Update"
+	ScaleHandlerService_Delete_FullMethodName         = "/scalehandler.ScaleHandlerService/Delete"
+	ScaleHandlerService_Render_FullMethodName         = "/scalehandler.ScaleHandlerService/Render"
+	ScaleHandlerService_Pause_FullMethodName          = "/scalehandler.ScaleHandlerService/Pause"
+	ScaleHandlerService_Resume_FullMethodName         = "/scalehandler.ScaleHandlerService/Resume"
+	ScaleHandlerService_CreateOverride_FullMethodName = "/scalehandler.ScaleHandlerService/CreateOverride"
+	ScaleHandlerService_ListOverrides_FullMethodName  = "/scalehandler.ScaleHandlerService/ListOverrides"
+	ScaleHandlerService_CancelOverride_FullMethodName = "/scalehandler.ScaleHandlerService/CancelOverride"
+	ScaleHandlerService_CollectGarbage_FullMethodName = "/scalehandler.ScaleHandlerService/CollectGarbage"
 )
 
 // ScaleHandlerServiceClient is the client API for ScaleHandlerService service.
@@ -35,6 +42,13 @@ type ScaleHandlerServiceClient interface {
 	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	Render(ctx context.Context, in *RenderRequest, opts ...grpc.CallOption) (*RenderResponse, error)
+	Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error)
+	Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error)
+	CreateOverride(ctx context.Context, in *CreateOverrideRequest, opts ...grpc.CallOption) (*CreateOverrideResponse, error)
+	ListOverrides(ctx context.Context, in *ListOverridesRequest, opts ...grpc.CallOption) (*ListOverridesResponse, error)
+	CancelOverride(ctx context.Context, in *CancelOverrideRequest, opts ...grpc.CallOption) (*CancelOverrideResponse, error)
+	CollectGarbage(ctx context.Context, in *CollectGarbageRequest, opts ...grpc.CallOption) (*CollectGarbageResponse, error)
 }
 
 type scaleHandlerServiceClient struct {
@@ -95,6 +109,76 @@ func (c *scaleHandlerServiceClient) Delete(ctx context.Context, in *DeleteReques
 	return out, nil
 }
 
+func (c *scaleHandlerServiceClient) Render(ctx context.Context, in *RenderRequest, opts ...grpc.CallOption) (*RenderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RenderResponse)
+	err := c.cc.Invoke(ctx, ScaleHandlerService_Render_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scaleHandlerServiceClient) Pause(ctx context.Context, in *PauseRequest, opts ...grpc.CallOption) (*PauseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseResponse)
+	err := c.cc.Invoke(ctx, ScaleHandlerService_Pause_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scaleHandlerServiceClient) Resume(ctx context.Context, in *ResumeRequest, opts ...grpc.CallOption) (*ResumeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeResponse)
+	err := c.cc.Invoke(ctx, ScaleHandlerService_Resume_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scaleHandlerServiceClient) CreateOverride(ctx context.Context, in *CreateOverrideRequest, opts ...grpc.CallOption) (*CreateOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateOverrideResponse)
+	err := c.cc.Invoke(ctx, ScaleHandlerService_CreateOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scaleHandlerServiceClient) ListOverrides(ctx context.Context, in *ListOverridesRequest, opts ...grpc.CallOption) (*ListOverridesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOverridesResponse)
+	err := c.cc.Invoke(ctx, ScaleHandlerService_ListOverrides_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scaleHandlerServiceClient) CancelOverride(ctx context.Context, in *CancelOverrideRequest, opts ...grpc.CallOption) (*CancelOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOverrideResponse)
+	err := c.cc.Invoke(ctx, ScaleHandlerService_CancelOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scaleHandlerServiceClient) CollectGarbage(ctx context.Context, in *CollectGarbageRequest, opts ...grpc.CallOption) (*CollectGarbageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CollectGarbageResponse)
+	err := c.cc.Invoke(ctx, ScaleHandlerService_CollectGarbage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ScaleHandlerServiceServer is the server API for ScaleHandlerService service.
 // All implementations must embed UnimplementedScaleHandlerServiceServer
 // for forward compatibility.
@@ -104,6 +188,13 @@ type ScaleHandlerServiceServer interface {
 	Get(context.Context, *GetRequest) (*GetResponse, error)
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	Render(context.Context, *RenderRequest) (*RenderResponse, error)
+	Pause(context.Context, *PauseRequest) (*PauseResponse, error)
+	Resume(context.Context, *ResumeRequest) (*ResumeResponse, error)
+	CreateOverride(context.Context, *CreateOverrideRequest) (*CreateOverrideResponse, error)
+	ListOverrides(context.Context, *ListOverridesRequest) (*ListOverridesResponse, error)
+	CancelOverride(context.Context, *CancelOverrideRequest) (*CancelOverrideResponse, error)
+	CollectGarbage(context.Context, *CollectGarbageRequest) (*CollectGarbageResponse, error)
 	mustEmbedUnimplementedScaleHandlerServiceServer()
 }
 
@@ -129,6 +220,27 @@ func (UnimplementedScaleHandlerServiceServer) Update(context.Context, *UpdateReq
 func (UnimplementedScaleHandlerServiceServer) Delete(context.Context, *DeleteRequest) (*DeleteResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedScaleHandlerServiceServer) Render(context.Context, *RenderRequest) (*RenderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Render not implemented")
+}
+func (UnimplementedScaleHandlerServiceServer) Pause(context.Context, *PauseRequest) (*PauseResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Pause not implemented")
+}
+func (UnimplementedScaleHandlerServiceServer) Resume(context.Context, *ResumeRequest) (*ResumeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Resume not implemented")
+}
+func (UnimplementedScaleHandlerServiceServer) CreateOverride(context.Context, *CreateOverrideRequest) (*CreateOverrideResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateOverride not implemented")
+}
+func (UnimplementedScaleHandlerServiceServer) ListOverrides(context.Context, *ListOverridesRequest) (*ListOverridesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListOverrides not implemented")
+}
+func (UnimplementedScaleHandlerServiceServer) CancelOverride(context.Context, *CancelOverrideRequest) (*CancelOverrideResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CancelOverride not implemented")
+}
+func (UnimplementedScaleHandlerServiceServer) CollectGarbage(context.Context, *CollectGarbageRequest) (*CollectGarbageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CollectGarbage not implemented")
+}
 func (UnimplementedScaleHandlerServiceServer) mustEmbedUnimplementedScaleHandlerServiceServer() {}
 func (UnimplementedScaleHandlerServiceServer) testEmbeddedByValue()                             {}
 
@@ -240,6 +352,132 @@ func _ScaleHandlerService_Delete_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ScaleHandlerService_Render_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScaleHandlerServiceServer).Render(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScaleHandlerService_Render_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScaleHandlerServiceServer).Render(ctx, req.(*RenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScaleHandlerService_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScaleHandlerServiceServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScaleHandlerService_Pause_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScaleHandlerServiceServer).Pause(ctx, req.(*PauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScaleHandlerService_Resume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScaleHandlerServiceServer).Resume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScaleHandlerService_Resume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScaleHandlerServiceServer).Resume(ctx, req.(*ResumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScaleHandlerService_CreateOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScaleHandlerServiceServer).CreateOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScaleHandlerService_CreateOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScaleHandlerServiceServer).CreateOverride(ctx, req.(*CreateOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScaleHandlerService_ListOverrides_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOverridesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScaleHandlerServiceServer).ListOverrides(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScaleHandlerService_ListOverrides_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScaleHandlerServiceServer).ListOverrides(ctx, req.(*ListOverridesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScaleHandlerService_CancelOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOverrideRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScaleHandlerServiceServer).CancelOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScaleHandlerService_CancelOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScaleHandlerServiceServer).CancelOverride(ctx, req.(*CancelOverrideRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ScaleHandlerService_CollectGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CollectGarbageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScaleHandlerServiceServer).CollectGarbage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ScaleHandlerService_CollectGarbage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScaleHandlerServiceServer).CollectGarbage(ctx, req.(*CollectGarbageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ScaleHandlerService_ServiceDesc is the grpc.ServiceDesc for ScaleHandlerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +505,34 @@ var ScaleHandlerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ScaleHandlerService_Delete_Handler,
 		},
+		{
+			MethodName: "Render",
+			Handler:    _ScaleHandlerService_Render_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _ScaleHandlerService_Pause_Handler,
+		},
+		{
+			MethodName: "Resume",
+			Handler:    _ScaleHandlerService_Resume_Handler,
+		},
+		{
+			MethodName: "CreateOverride",
+			Handler:    _ScaleHandlerService_CreateOverride_Handler,
+		},
+		{
+			MethodName: "ListOverrides",
+			Handler:    _ScaleHandlerService_ListOverrides_Handler,
+		},
+		{
+			MethodName: "CancelOverride",
+			Handler:    _ScaleHandlerService_CancelOverride_Handler,
+		},
+		{
+			MethodName: "CollectGarbage",
+			Handler:    _ScaleHandlerService_CollectGarbage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -11,9 +11,24 @@ func DTOToProto(dto *ScheduleDTO) *scalehandlerv1.Schedule {
 	}
 
 	proto := &scalehandlerv1.Schedule{
-		Weekdays:   make(map[string]*scalehandlerv1.Schedule_DaySchedule),
-		Dates:      make(map[string]*scalehandlerv1.Schedule_DaySchedule),
-		Exceptions: dto.Exceptions,
+		Weekdays:        make(map[string]*scalehandlerv1.Schedule_DaySchedule),
+		Dates:           make(map[string]*scalehandlerv1.Schedule_DaySchedule),
+		Exceptions:      dto.Exceptions,
+		Timezone:        dto.Timezone,
+		Namespace:       dto.Namespace,
+		Scaling:         scalingDTOToProto(dto.Scaling),
+		DefaultReplicas: dto.DefaultReplicas,
+		Triggers:        triggersDTOToProto(dto.Triggers),
+		Labels:          dto.Labels,
+		Annotations:     dto.Annotations,
+	}
+	if dto.Target != nil {
+		proto.Target = &scalehandlerv1.TargetRef{
+			ApiVersion: dto.Target.APIVersion,
+			Kind:       dto.Target.Kind,
+			Name:       dto.Target.Name,
+			Namespace:  dto.Target.Namespace,
+		}
 	}
 
 	for day, ranges := range dto.Weekdays {
@@ -38,9 +53,24 @@ func ProtoToDTO(proto *scalehandlerv1.Schedule) *ScheduleDTO {
 	}
 
 	dto := &ScheduleDTO{
-		Weekdays:   make(map[string][]TimeRangeDTO),
-		Dates:      make(map[string][]TimeRangeDTO),
-		Exceptions: proto.Exceptions,
+		Weekdays:        make(map[string][]TimeRangeDTO),
+		Dates:           make(map[string][]TimeRangeDTO),
+		Exceptions:      proto.Exceptions,
+		Timezone:        proto.Timezone,
+		Namespace:       proto.Namespace,
+		Scaling:         scalingProtoToDTO(proto.Scaling),
+		DefaultReplicas: proto.DefaultReplicas,
+		Triggers:        triggersProtoToDTO(proto.Triggers),
+		Labels:          proto.Labels,
+		Annotations:     proto.Annotations,
+	}
+	if proto.Target != nil {
+		dto.Target = &TargetRefDTO{
+			APIVersion: proto.Target.ApiVersion,
+			Kind:       proto.Target.Kind,
+			Name:       proto.Target.Name,
+			Namespace:  proto.Target.Namespace,
+		}
 	}
 
 	for day, daySchedule := range proto.Weekdays {
@@ -90,17 +120,123 @@ func timeRangesToDTO(ranges []*scalehandlerv1.TimeRange) []TimeRangeDTO {
 	return result
 }
 
+func scalingDTOToProto(dto *ScalingOptionsDTO) *scalehandlerv1.ScalingOptions {
+	if dto == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.ScalingOptions{
+		MinReplicaCount: dto.MinReplicaCount,
+		MaxReplicaCount: dto.MaxReplicaCount,
+		CooldownPeriod:  dto.CooldownPeriod,
+		PollingInterval: dto.PollingInterval,
+	}
+	if dto.Fallback != nil {
+		proto.Fallback = &scalehandlerv1.Fallback{
+			FailureThreshold: dto.Fallback.FailureThreshold,
+			Replicas:         dto.Fallback.Replicas,
+		}
+	}
+	if dto.Behavior != nil {
+		proto.Behavior = &scalehandlerv1.ScalingBehavior{
+			ScaleUp:   scalingRulesDTOToProto(dto.Behavior.ScaleUp),
+			ScaleDown: scalingRulesDTOToProto(dto.Behavior.ScaleDown),
+		}
+	}
+	return proto
+}
+
+func scalingProtoToDTO(proto *scalehandlerv1.ScalingOptions) *ScalingOptionsDTO {
+	if proto == nil {
+		return nil
+	}
+	dto := &ScalingOptionsDTO{
+		MinReplicaCount: proto.MinReplicaCount,
+		MaxReplicaCount: proto.MaxReplicaCount,
+		CooldownPeriod:  proto.CooldownPeriod,
+		PollingInterval: proto.PollingInterval,
+	}
+	if proto.Fallback != nil {
+		dto.Fallback = &FallbackDTO{
+			FailureThreshold: proto.Fallback.FailureThreshold,
+			Replicas:         proto.Fallback.Replicas,
+		}
+	}
+	if proto.Behavior != nil {
+		dto.Behavior = &ScalingBehaviorDTO{
+			ScaleUp:   scalingRulesProtoToDTO(proto.Behavior.ScaleUp),
+			ScaleDown: scalingRulesProtoToDTO(proto.Behavior.ScaleDown),
+		}
+	}
+	return dto
+}
+
+func scalingRulesDTOToProto(dto *ScalingRulesDTO) *scalehandlerv1.ScalingRules {
+	if dto == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.ScalingRules{
+		StabilizationWindowSeconds: dto.StabilizationWindowSeconds,
+		SelectPolicy:               dto.SelectPolicy,
+	}
+	for _, p := range dto.Policies {
+		proto.Policies = append(proto.Policies, &scalehandlerv1.ScalingPolicy{
+			Type:          p.Type,
+			Value:         p.Value,
+			PeriodSeconds: p.PeriodSeconds,
+		})
+	}
+	return proto
+}
+
+func scalingRulesProtoToDTO(proto *scalehandlerv1.ScalingRules) *ScalingRulesDTO {
+	if proto == nil {
+		return nil
+	}
+	dto := &ScalingRulesDTO{
+		StabilizationWindowSeconds: proto.StabilizationWindowSeconds,
+		SelectPolicy:               proto.SelectPolicy,
+	}
+	for _, p := range proto.Policies {
+		if p != nil {
+			dto.Policies = append(dto.Policies, ScalingPolicyDTO{
+				Type:          p.Type,
+				Value:         p.Value,
+				PeriodSeconds: p.PeriodSeconds,
+			})
+		}
+	}
+	return dto
+}
+
 // ApplicationDTOToProto конвертирует Application DTO в proto
 func ApplicationDTOToProto(dto *ApplicationDTO) *scalehandlerv1.Application {
 	if dto == nil {
 		return nil
 	}
 	proto := &scalehandlerv1.Application{
+		Kind:       dto.Kind,
 		Containers: make([]*scalehandlerv1.Container, len(dto.Containers)),
+		Service:    serviceDTOToProto(dto.Service),
+		Ingress:    ingressDTOToProto(dto.Ingress),
+		Volumes:    volumesDTOToProto(dto.Volumes),
+
+		NodeSelector:              dto.NodeSelector,
+		Tolerations:               tolerationsDTOToProto(dto.Tolerations),
+		Affinity:                  affinityDTOToProto(dto.Affinity),
+		TopologySpreadConstraints: topologySpreadDTOToProto(dto.TopologySpreadConstraints),
+		PriorityClassName:         dto.PriorityClassName,
+
+		ImagePullSecrets:              dto.ImagePullSecrets,
+		ServiceAccountName:            dto.ServiceAccountName,
+		TerminationGracePeriodSeconds: dto.TerminationGracePeriodSeconds,
+		SecurityContext:               podSecurityContextDTOToProto(dto.SecurityContext),
 	}
 	for i, c := range dto.Containers {
 		proto.Containers[i] = containerDTOToProto(&c)
 	}
+	for _, c := range dto.InitContainers {
+		proto.InitContainers = append(proto.InitContainers, containerDTOToProto(&c))
+	}
 	return proto
 }
 
@@ -110,23 +246,110 @@ func ProtoToApplicationDTO(proto *scalehandlerv1.Application) *ApplicationDTO {
 		return nil
 	}
 	dto := &ApplicationDTO{
+		Kind:       proto.Kind,
 		Containers: make([]ContainerDTO, len(proto.Containers)),
+		Service:    serviceProtoToDTO(proto.Service),
+		Ingress:    ingressProtoToDTO(proto.Ingress),
+		Volumes:    volumesProtoToDTO(proto.Volumes),
+
+		NodeSelector:              proto.NodeSelector,
+		Tolerations:               tolerationsProtoToDTO(proto.Tolerations),
+		Affinity:                  affinityProtoToDTO(proto.Affinity),
+		TopologySpreadConstraints: topologySpreadProtoToDTO(proto.TopologySpreadConstraints),
+		PriorityClassName:         proto.PriorityClassName,
+
+		ImagePullSecrets:              proto.ImagePullSecrets,
+		ServiceAccountName:            proto.ServiceAccountName,
+		TerminationGracePeriodSeconds: proto.TerminationGracePeriodSeconds,
+		SecurityContext:               podSecurityContextProtoToDTO(proto.SecurityContext),
 	}
 	for i, c := range proto.Containers {
 		if c != nil {
 			dto.Containers[i] = *containerProtoToDTO(c)
 		}
 	}
+	for _, c := range proto.InitContainers {
+		if c != nil {
+			dto.InitContainers = append(dto.InitContainers, *containerProtoToDTO(c))
+		}
+	}
+	return dto
+}
+
+func serviceDTOToProto(dto *ServiceSpecDTO) *scalehandlerv1.ServiceSpec {
+	if dto == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.ServiceSpec{Type: dto.Type}
+	for _, p := range dto.Ports {
+		proto.Ports = append(proto.Ports, &scalehandlerv1.ServicePort{
+			Name:       p.Name,
+			Port:       p.Port,
+			TargetPort: p.TargetPort,
+			Protocol:   p.Protocol,
+		})
+	}
+	return proto
+}
+
+func serviceProtoToDTO(proto *scalehandlerv1.ServiceSpec) *ServiceSpecDTO {
+	if proto == nil {
+		return nil
+	}
+	dto := &ServiceSpecDTO{Type: proto.Type}
+	for _, p := range proto.Ports {
+		if p != nil {
+			dto.Ports = append(dto.Ports, ServicePortDTO{
+				Name:       p.Name,
+				Port:       p.Port,
+				TargetPort: p.TargetPort,
+				Protocol:   p.Protocol,
+			})
+		}
+	}
 	return dto
 }
 
+func ingressDTOToProto(dto *IngressSpecDTO) *scalehandlerv1.IngressSpec {
+	if dto == nil {
+		return nil
+	}
+	return &scalehandlerv1.IngressSpec{
+		ClassName:     dto.ClassName,
+		Host:          dto.Host,
+		Path:          dto.Path,
+		PathType:      dto.PathType,
+		ServicePort:   dto.ServicePort,
+		TlsSecretName: dto.TLSSecretName,
+	}
+}
+
+func ingressProtoToDTO(proto *scalehandlerv1.IngressSpec) *IngressSpecDTO {
+	if proto == nil {
+		return nil
+	}
+	return &IngressSpecDTO{
+		ClassName:     proto.ClassName,
+		Host:          proto.Host,
+		Path:          proto.Path,
+		PathType:      proto.PathType,
+		ServicePort:   proto.ServicePort,
+		TLSSecretName: proto.TlsSecretName,
+	}
+}
+
 func containerDTOToProto(c *ContainerDTO) *scalehandlerv1.Container {
 	if c == nil {
 		return nil
 	}
 	proto := &scalehandlerv1.Container{
-		Name:  c.Name,
-		Image: c.Image,
+		Name:            c.Name,
+		Image:           c.Image,
+		Command:         c.Command,
+		Args:            c.Args,
+		ImagePullPolicy: c.ImagePullPolicy,
+		SecurityContext: securityContextDTOToProto(c.SecurityContext),
+		Lifecycle:       lifecycleDTOToProto(c.Lifecycle),
 	}
 	if len(c.Ports) > 0 {
 		proto.Ports = make([]*scalehandlerv1.ContainerPort, len(c.Ports))
@@ -137,12 +360,9 @@ func containerDTOToProto(c *ContainerDTO) *scalehandlerv1.Container {
 			}
 		}
 	}
-	if len(c.Env) > 0 {
-		proto.Env = make([]*scalehandlerv1.EnvVar, len(c.Env))
-		for i, e := range c.Env {
-			proto.Env[i] = &scalehandlerv1.EnvVar{Name: e.Name, Value: e.Value}
-		}
-	}
+	proto.Env = envDTOToProto(c.Env)
+	proto.EnvFrom = envFromDTOToProto(c.EnvFrom)
+	proto.VolumeMounts = volumeMountsDTOToProto(c.VolumeMounts)
 	if c.Resources != nil {
 		proto.Resources = &scalehandlerv1.Resources{}
 		if c.Resources.Requests != nil {
@@ -158,20 +378,9 @@ func containerDTOToProto(c *ContainerDTO) *scalehandlerv1.Container {
 			}
 		}
 	}
-	if c.LivenessProbe != nil && c.LivenessProbe.HTTPGet != nil {
-		proto.LivenessProbe = &scalehandlerv1.Probe{
-			HttpGet:             &scalehandlerv1.HttpGetAction{Path: c.LivenessProbe.HTTPGet.Path, Port: c.LivenessProbe.HTTPGet.Port},
-			InitialDelaySeconds: c.LivenessProbe.InitialDelaySeconds,
-			PeriodSeconds:       c.LivenessProbe.PeriodSeconds,
-		}
-	}
-	if c.ReadinessProbe != nil && c.ReadinessProbe.HTTPGet != nil {
-		proto.ReadinessProbe = &scalehandlerv1.Probe{
-			HttpGet:             &scalehandlerv1.HttpGetAction{Path: c.ReadinessProbe.HTTPGet.Path, Port: c.ReadinessProbe.HTTPGet.Port},
-			InitialDelaySeconds: c.ReadinessProbe.InitialDelaySeconds,
-			PeriodSeconds:       c.ReadinessProbe.PeriodSeconds,
-		}
-	}
+	proto.LivenessProbe = probeDTOToProto(c.LivenessProbe)
+	proto.ReadinessProbe = probeDTOToProto(c.ReadinessProbe)
+	proto.StartupProbe = probeDTOToProto(c.StartupProbe)
 	return proto
 }
 
@@ -180,8 +389,13 @@ func containerProtoToDTO(proto *scalehandlerv1.Container) *ContainerDTO {
 		return nil
 	}
 	c := &ContainerDTO{
-		Name:  proto.Name,
-		Image: proto.Image,
+		Name:            proto.Name,
+		Image:           proto.Image,
+		Command:         proto.Command,
+		Args:            proto.Args,
+		ImagePullPolicy: proto.ImagePullPolicy,
+		SecurityContext: securityContextProtoToDTO(proto.SecurityContext),
+		Lifecycle:       lifecycleProtoToDTO(proto.Lifecycle),
 	}
 	if len(proto.Ports) > 0 {
 		c.Ports = make([]ContainerPortDTO, len(proto.Ports))
@@ -191,14 +405,9 @@ func containerProtoToDTO(proto *scalehandlerv1.Container) *ContainerDTO {
 			}
 		}
 	}
-	if len(proto.Env) > 0 {
-		c.Env = make([]EnvVarDTO, len(proto.Env))
-		for i, e := range proto.Env {
-			if e != nil {
-				c.Env[i] = EnvVarDTO{Name: e.Name, Value: e.Value}
-			}
-		}
-	}
+	c.Env = envProtoToDTO(proto.Env)
+	c.EnvFrom = envFromProtoToDTO(proto.EnvFrom)
+	c.VolumeMounts = volumeMountsProtoToDTO(proto.VolumeMounts)
 	if proto.Resources != nil {
 		c.Resources = &ResourcesDTO{}
 		if proto.Resources.Requests != nil {
@@ -214,19 +423,735 @@ func containerProtoToDTO(proto *scalehandlerv1.Container) *ContainerDTO {
 			}
 		}
 	}
-	if proto.LivenessProbe != nil && proto.LivenessProbe.HttpGet != nil {
-		c.LivenessProbe = &ProbeDTO{
-			HTTPGet:             &HTTPGetActionDTO{Path: proto.LivenessProbe.HttpGet.Path, Port: proto.LivenessProbe.HttpGet.Port},
-			InitialDelaySeconds: proto.LivenessProbe.InitialDelaySeconds,
-			PeriodSeconds:       proto.LivenessProbe.PeriodSeconds,
+	c.LivenessProbe = probeProtoToDTO(proto.LivenessProbe)
+	c.ReadinessProbe = probeProtoToDTO(proto.ReadinessProbe)
+	c.StartupProbe = probeProtoToDTO(proto.StartupProbe)
+	return c
+}
+
+// StatusProtoToDTO конвертирует статус расписания из protobuf
+func StatusProtoToDTO(proto *scalehandlerv1.ScheduleStatus) *ScheduleStatusDTO {
+	if proto == nil {
+		return nil
+	}
+	status := &ScheduleStatusDTO{
+		Phase:             proto.Phase,
+		LastAppliedAt:     proto.LastAppliedAt,
+		LastError:         proto.LastError,
+		AppliedGeneration: proto.AppliedGeneration,
+		Generation:        proto.Generation,
+		DefaultReplicas:   proto.DefaultReplicas,
+		MinReplicaCount:   proto.MinReplicaCount,
+		MaxReplicaCount:   proto.MaxReplicaCount,
+	}
+	if p := proto.Pause; p != nil {
+		status.Pause = &PauseStateDTO{
+			Replicas: p.Replicas,
+			PausedBy: p.PausedBy,
+			Reason:   p.Reason,
+			PausedAt: p.PausedAt,
 		}
 	}
-	if proto.ReadinessProbe != nil && proto.ReadinessProbe.HttpGet != nil {
-		c.ReadinessProbe = &ProbeDTO{
-			HTTPGet:             &HTTPGetActionDTO{Path: proto.ReadinessProbe.HttpGet.Path, Port: proto.ReadinessProbe.HttpGet.Port},
-			InitialDelaySeconds: proto.ReadinessProbe.InitialDelaySeconds,
-			PeriodSeconds:       proto.ReadinessProbe.PeriodSeconds,
+	return status
+}
+
+func OverrideProtoToDTO(proto *scalehandlerv1.Override) *OverrideDTO {
+	if proto == nil {
+		return nil
+	}
+	return &OverrideDTO{
+		ID:        proto.Id,
+		Replicas:  proto.Replicas,
+		Start:     proto.Start,
+		End:       proto.End,
+		Reason:    proto.Reason,
+		CreatedAt: proto.CreatedAt,
+		Active:    proto.Active,
+	}
+}
+
+func GCReportProtoToDTO(proto *scalehandlerv1.CollectGarbageResponse) *GCReportDTO {
+	report := &GCReportDTO{
+		Checked: proto.Checked,
+		Orphans: make([]OrphanedResourceDTO, len(proto.Orphans)),
+		Errors:  proto.Errors,
+	}
+	for i, o := range proto.Orphans {
+		report.Orphans[i] = OrphanedResourceDTO{
+			ScheduleID: o.ScheduleId,
+			Kind:       o.Kind,
+			Namespace:  o.Namespace,
+			Name:       o.Name,
+			OrphanedAt: o.OrphanedAt,
+			Action:     o.Action,
 		}
 	}
-	return c
+	return report
+}
+
+func envDTOToProto(env []EnvVarDTO) []*scalehandlerv1.EnvVar {
+	if len(env) == 0 {
+		return nil
+	}
+	result := make([]*scalehandlerv1.EnvVar, len(env))
+	for i, e := range env {
+		result[i] = &scalehandlerv1.EnvVar{Name: e.Name, Value: e.Value}
+		if src := e.ValueFrom; src != nil {
+			result[i].ValueFrom = &scalehandlerv1.EnvVarSource{
+				SecretKeyRef:    keySelectorDTOToProto(src.SecretKeyRef),
+				ConfigMapKeyRef: keySelectorDTOToProto(src.ConfigMapKeyRef),
+			}
+			if src.FieldRef != nil {
+				result[i].ValueFrom.FieldRef = &scalehandlerv1.FieldRef{FieldPath: src.FieldRef.FieldPath}
+			}
+		}
+	}
+	return result
+}
+
+func envProtoToDTO(env []*scalehandlerv1.EnvVar) []EnvVarDTO {
+	if len(env) == 0 {
+		return nil
+	}
+	result := make([]EnvVarDTO, 0, len(env))
+	for _, e := range env {
+		if e == nil {
+			continue
+		}
+		v := EnvVarDTO{Name: e.Name, Value: e.Value}
+		if src := e.ValueFrom; src != nil {
+			v.ValueFrom = &EnvVarSourceDTO{
+				SecretKeyRef:    keySelectorProtoToDTO(src.SecretKeyRef),
+				ConfigMapKeyRef: keySelectorProtoToDTO(src.ConfigMapKeyRef),
+			}
+			if src.FieldRef != nil {
+				v.ValueFrom.FieldRef = &FieldRefDTO{FieldPath: src.FieldRef.FieldPath}
+			}
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+func envFromDTOToProto(envFrom []EnvFromSourceDTO) []*scalehandlerv1.EnvFromSource {
+	var result []*scalehandlerv1.EnvFromSource
+	for _, e := range envFrom {
+		result = append(result, &scalehandlerv1.EnvFromSource{
+			Prefix:       e.Prefix,
+			SecretRef:    objectRefDTOToProto(e.SecretRef),
+			ConfigMapRef: objectRefDTOToProto(e.ConfigMapRef),
+		})
+	}
+	return result
+}
+
+func envFromProtoToDTO(envFrom []*scalehandlerv1.EnvFromSource) []EnvFromSourceDTO {
+	var result []EnvFromSourceDTO
+	for _, e := range envFrom {
+		if e != nil {
+			result = append(result, EnvFromSourceDTO{
+				Prefix:       e.Prefix,
+				SecretRef:    objectRefProtoToDTO(e.SecretRef),
+				ConfigMapRef: objectRefProtoToDTO(e.ConfigMapRef),
+			})
+		}
+	}
+	return result
+}
+
+func keySelectorDTOToProto(s *KeySelectorDTO) *scalehandlerv1.KeySelector {
+	if s == nil {
+		return nil
+	}
+	return &scalehandlerv1.KeySelector{Name: s.Name, Key: s.Key, Optional: s.Optional}
+}
+
+func keySelectorProtoToDTO(s *scalehandlerv1.KeySelector) *KeySelectorDTO {
+	if s == nil {
+		return nil
+	}
+	return &KeySelectorDTO{Name: s.Name, Key: s.Key, Optional: s.Optional}
+}
+
+func objectRefDTOToProto(ref *LocalObjectRefDTO) *scalehandlerv1.LocalObjectRef {
+	if ref == nil {
+		return nil
+	}
+	return &scalehandlerv1.LocalObjectRef{Name: ref.Name, Optional: ref.Optional}
+}
+
+func objectRefProtoToDTO(ref *scalehandlerv1.LocalObjectRef) *LocalObjectRefDTO {
+	if ref == nil {
+		return nil
+	}
+	return &LocalObjectRefDTO{Name: ref.Name, Optional: ref.Optional}
+}
+
+func volumesDTOToProto(volumes []VolumeDTO) []*scalehandlerv1.Volume {
+	var result []*scalehandlerv1.Volume
+	for _, v := range volumes {
+		proto := &scalehandlerv1.Volume{
+			Name:      v.Name,
+			ConfigMap: objectVolumeDTOToProto(v.ConfigMap),
+			Secret:    objectVolumeDTOToProto(v.Secret),
+		}
+		if v.EmptyDir != nil {
+			proto.EmptyDir = &scalehandlerv1.EmptyDirVolume{Medium: v.EmptyDir.Medium, SizeLimit: v.EmptyDir.SizeLimit}
+		}
+		if v.PersistentVolumeClaim != nil {
+			proto.PersistentVolumeClaim = &scalehandlerv1.PersistentVolumeClaimVolume{
+				ClaimName: v.PersistentVolumeClaim.ClaimName,
+				ReadOnly:  v.PersistentVolumeClaim.ReadOnly,
+			}
+		}
+		if v.Projected != nil {
+			proto.Projected = &scalehandlerv1.ProjectedVolume{DefaultMode: v.Projected.DefaultMode}
+			for _, s := range v.Projected.Sources {
+				source := &scalehandlerv1.VolumeProjection{
+					ConfigMap: objectVolumeDTOToProto(s.ConfigMap),
+					Secret:    objectVolumeDTOToProto(s.Secret),
+				}
+				if t := s.ServiceAccountToken; t != nil {
+					source.ServiceAccountToken = &scalehandlerv1.ServiceAccountTokenProjection{
+						Audience:          t.Audience,
+						ExpirationSeconds: t.ExpirationSeconds,
+						Path:              t.Path,
+					}
+				}
+				proto.Projected.Sources = append(proto.Projected.Sources, source)
+			}
+		}
+		result = append(result, proto)
+	}
+	return result
+}
+
+func volumesProtoToDTO(volumes []*scalehandlerv1.Volume) []VolumeDTO {
+	var result []VolumeDTO
+	for _, proto := range volumes {
+		if proto == nil {
+			continue
+		}
+		v := VolumeDTO{
+			Name:      proto.Name,
+			ConfigMap: objectVolumeProtoToDTO(proto.ConfigMap),
+			Secret:    objectVolumeProtoToDTO(proto.Secret),
+		}
+		if proto.EmptyDir != nil {
+			v.EmptyDir = &EmptyDirVolumeDTO{Medium: proto.EmptyDir.Medium, SizeLimit: proto.EmptyDir.SizeLimit}
+		}
+		if proto.PersistentVolumeClaim != nil {
+			v.PersistentVolumeClaim = &PersistentVolumeClaimDTO{
+				ClaimName: proto.PersistentVolumeClaim.ClaimName,
+				ReadOnly:  proto.PersistentVolumeClaim.ReadOnly,
+			}
+		}
+		if proto.Projected != nil {
+			v.Projected = &ProjectedVolumeDTO{DefaultMode: proto.Projected.DefaultMode}
+			for _, s := range proto.Projected.Sources {
+				if s == nil {
+					continue
+				}
+				source := VolumeProjectionDTO{
+					ConfigMap: objectVolumeProtoToDTO(s.ConfigMap),
+					Secret:    objectVolumeProtoToDTO(s.Secret),
+				}
+				if t := s.ServiceAccountToken; t != nil {
+					source.ServiceAccountToken = &ServiceAccountTokenProjectionDTO{
+						Audience:          t.Audience,
+						ExpirationSeconds: t.ExpirationSeconds,
+						Path:              t.Path,
+					}
+				}
+				v.Projected.Sources = append(v.Projected.Sources, source)
+			}
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+func objectVolumeDTOToProto(v *ObjectVolumeDTO) *scalehandlerv1.ObjectVolume {
+	if v == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.ObjectVolume{Name: v.Name, DefaultMode: v.DefaultMode, Optional: v.Optional}
+	for _, item := range v.Items {
+		proto.Items = append(proto.Items, &scalehandlerv1.KeyToPath{Key: item.Key, Path: item.Path, Mode: item.Mode})
+	}
+	return proto
+}
+
+func objectVolumeProtoToDTO(proto *scalehandlerv1.ObjectVolume) *ObjectVolumeDTO {
+	if proto == nil {
+		return nil
+	}
+	v := &ObjectVolumeDTO{Name: proto.Name, DefaultMode: proto.DefaultMode, Optional: proto.Optional}
+	for _, item := range proto.Items {
+		if item != nil {
+			v.Items = append(v.Items, KeyToPathDTO{Key: item.Key, Path: item.Path, Mode: item.Mode})
+		}
+	}
+	return v
+}
+
+func volumeMountsDTOToProto(mounts []VolumeMountDTO) []*scalehandlerv1.VolumeMount {
+	var result []*scalehandlerv1.VolumeMount
+	for _, m := range mounts {
+		result = append(result, &scalehandlerv1.VolumeMount{
+			Name:      m.Name,
+			MountPath: m.MountPath,
+			SubPath:   m.SubPath,
+			ReadOnly:  m.ReadOnly,
+		})
+	}
+	return result
+}
+
+func volumeMountsProtoToDTO(mounts []*scalehandlerv1.VolumeMount) []VolumeMountDTO {
+	var result []VolumeMountDTO
+	for _, m := range mounts {
+		if m != nil {
+			result = append(result, VolumeMountDTO{
+				Name:      m.Name,
+				MountPath: m.MountPath,
+				SubPath:   m.SubPath,
+				ReadOnly:  m.ReadOnly,
+			})
+		}
+	}
+	return result
+}
+
+func tolerationsDTOToProto(tolerations []TolerationDTO) []*scalehandlerv1.Toleration {
+	var result []*scalehandlerv1.Toleration
+	for _, t := range tolerations {
+		result = append(result, &scalehandlerv1.Toleration{
+			Key:               t.Key,
+			Operator:          t.Operator,
+			Value:             t.Value,
+			Effect:            t.Effect,
+			TolerationSeconds: t.TolerationSeconds,
+		})
+	}
+	return result
+}
+
+func tolerationsProtoToDTO(tolerations []*scalehandlerv1.Toleration) []TolerationDTO {
+	var result []TolerationDTO
+	for _, t := range tolerations {
+		if t != nil {
+			result = append(result, TolerationDTO{
+				Key:               t.Key,
+				Operator:          t.Operator,
+				Value:             t.Value,
+				Effect:            t.Effect,
+				TolerationSeconds: t.TolerationSeconds,
+			})
+		}
+	}
+	return result
+}
+
+func affinityDTOToProto(a *AffinityDTO) *scalehandlerv1.Affinity {
+	if a == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.Affinity{
+		PodAffinity:     podAffinityDTOToProto(a.PodAffinity),
+		PodAntiAffinity: podAffinityDTOToProto(a.PodAntiAffinity),
+	}
+	if na := a.NodeAffinity; na != nil {
+		proto.NodeAffinity = &scalehandlerv1.NodeAffinity{}
+		for _, term := range na.Required {
+			proto.NodeAffinity.Required = append(proto.NodeAffinity.Required, nodeSelectorTermDTOToProto(term))
+		}
+		for _, p := range na.Preferred {
+			proto.NodeAffinity.Preferred = append(proto.NodeAffinity.Preferred, &scalehandlerv1.PreferredSchedulingTerm{
+				Weight:     p.Weight,
+				Preference: nodeSelectorTermDTOToProto(p.Preference),
+			})
+		}
+	}
+	return proto
+}
+
+func affinityProtoToDTO(proto *scalehandlerv1.Affinity) *AffinityDTO {
+	if proto == nil {
+		return nil
+	}
+	a := &AffinityDTO{
+		PodAffinity:     podAffinityProtoToDTO(proto.PodAffinity),
+		PodAntiAffinity: podAffinityProtoToDTO(proto.PodAntiAffinity),
+	}
+	if na := proto.NodeAffinity; na != nil {
+		a.NodeAffinity = &NodeAffinityDTO{}
+		for _, term := range na.Required {
+			a.NodeAffinity.Required = append(a.NodeAffinity.Required, nodeSelectorTermProtoToDTO(term))
+		}
+		for _, p := range na.Preferred {
+			if p != nil {
+				a.NodeAffinity.Preferred = append(a.NodeAffinity.Preferred, PreferredSchedulingTermDTO{
+					Weight:     p.Weight,
+					Preference: nodeSelectorTermProtoToDTO(p.Preference),
+				})
+			}
+		}
+	}
+	return a
+}
+
+func nodeSelectorTermDTOToProto(term NodeSelectorTermDTO) *scalehandlerv1.NodeSelectorTerm {
+	return &scalehandlerv1.NodeSelectorTerm{MatchExpressions: requirementsDTOToProto(term.MatchExpressions)}
+}
+
+func nodeSelectorTermProtoToDTO(term *scalehandlerv1.NodeSelectorTerm) NodeSelectorTermDTO {
+	if term == nil {
+		return NodeSelectorTermDTO{}
+	}
+	return NodeSelectorTermDTO{MatchExpressions: requirementsProtoToDTO(term.MatchExpressions)}
+}
+
+func podAffinityDTOToProto(a *PodAffinityDTO) *scalehandlerv1.PodAffinity {
+	if a == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.PodAffinity{}
+	for _, term := range a.Required {
+		proto.Required = append(proto.Required, podAffinityTermDTOToProto(term))
+	}
+	for _, p := range a.Preferred {
+		proto.Preferred = append(proto.Preferred, &scalehandlerv1.WeightedPodAffinityTerm{
+			Weight: p.Weight,
+			Term:   podAffinityTermDTOToProto(p.Term),
+		})
+	}
+	return proto
+}
+
+func podAffinityProtoToDTO(proto *scalehandlerv1.PodAffinity) *PodAffinityDTO {
+	if proto == nil {
+		return nil
+	}
+	a := &PodAffinityDTO{}
+	for _, term := range proto.Required {
+		a.Required = append(a.Required, podAffinityTermProtoToDTO(term))
+	}
+	for _, p := range proto.Preferred {
+		if p != nil {
+			a.Preferred = append(a.Preferred, WeightedPodAffinityTermDTO{
+				Weight: p.Weight,
+				Term:   podAffinityTermProtoToDTO(p.Term),
+			})
+		}
+	}
+	return a
+}
+
+func podAffinityTermDTOToProto(term PodAffinityTermDTO) *scalehandlerv1.PodAffinityTerm {
+	return &scalehandlerv1.PodAffinityTerm{
+		LabelSelector: labelSelectorDTOToProto(term.LabelSelector),
+		TopologyKey:   term.TopologyKey,
+		Namespaces:    term.Namespaces,
+	}
+}
+
+func podAffinityTermProtoToDTO(term *scalehandlerv1.PodAffinityTerm) PodAffinityTermDTO {
+	if term == nil {
+		return PodAffinityTermDTO{}
+	}
+	return PodAffinityTermDTO{
+		LabelSelector: labelSelectorProtoToDTO(term.LabelSelector),
+		TopologyKey:   term.TopologyKey,
+		Namespaces:    term.Namespaces,
+	}
+}
+
+func topologySpreadDTOToProto(constraints []TopologySpreadConstraintDTO) []*scalehandlerv1.TopologySpreadConstraint {
+	var result []*scalehandlerv1.TopologySpreadConstraint
+	for _, c := range constraints {
+		result = append(result, &scalehandlerv1.TopologySpreadConstraint{
+			MaxSkew:           c.MaxSkew,
+			TopologyKey:       c.TopologyKey,
+			WhenUnsatisfiable: c.WhenUnsatisfiable,
+			LabelSelector:     labelSelectorDTOToProto(c.LabelSelector),
+			MinDomains:        c.MinDomains,
+		})
+	}
+	return result
+}
+
+func topologySpreadProtoToDTO(constraints []*scalehandlerv1.TopologySpreadConstraint) []TopologySpreadConstraintDTO {
+	var result []TopologySpreadConstraintDTO
+	for _, c := range constraints {
+		if c != nil {
+			result = append(result, TopologySpreadConstraintDTO{
+				MaxSkew:           c.MaxSkew,
+				TopologyKey:       c.TopologyKey,
+				WhenUnsatisfiable: c.WhenUnsatisfiable,
+				LabelSelector:     labelSelectorProtoToDTO(c.LabelSelector),
+				MinDomains:        c.MinDomains,
+			})
+		}
+	}
+	return result
+}
+
+func labelSelectorDTOToProto(s *LabelSelectorDTO) *scalehandlerv1.LabelSelector {
+	if s == nil {
+		return nil
+	}
+	return &scalehandlerv1.LabelSelector{
+		MatchLabels:      s.MatchLabels,
+		MatchExpressions: requirementsDTOToProto(s.MatchExpressions),
+	}
+}
+
+func labelSelectorProtoToDTO(s *scalehandlerv1.LabelSelector) *LabelSelectorDTO {
+	if s == nil {
+		return nil
+	}
+	return &LabelSelectorDTO{
+		MatchLabels:      s.MatchLabels,
+		MatchExpressions: requirementsProtoToDTO(s.MatchExpressions),
+	}
+}
+
+func requirementsDTOToProto(reqs []SelectorRequirementDTO) []*scalehandlerv1.SelectorRequirement {
+	var result []*scalehandlerv1.SelectorRequirement
+	for _, r := range reqs {
+		result = append(result, &scalehandlerv1.SelectorRequirement{Key: r.Key, Operator: r.Operator, Values: r.Values})
+	}
+	return result
+}
+
+func requirementsProtoToDTO(reqs []*scalehandlerv1.SelectorRequirement) []SelectorRequirementDTO {
+	var result []SelectorRequirementDTO
+	for _, r := range reqs {
+		if r != nil {
+			result = append(result, SelectorRequirementDTO{Key: r.Key, Operator: r.Operator, Values: r.Values})
+		}
+	}
+	return result
+}
+
+func probeDTOToProto(p *ProbeDTO) *scalehandlerv1.Probe {
+	if p == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.Probe{
+		InitialDelaySeconds: p.InitialDelaySeconds,
+		PeriodSeconds:       p.PeriodSeconds,
+		TimeoutSeconds:      p.TimeoutSeconds,
+		FailureThreshold:    p.FailureThreshold,
+		SuccessThreshold:    p.SuccessThreshold,
+		HttpGet:             httpGetDTOToProto(p.HTTPGet),
+		Exec:                execDTOToProto(p.Exec),
+	}
+	if p.TCPSocket != nil {
+		proto.TcpSocket = &scalehandlerv1.TcpSocketAction{Port: p.TCPSocket.Port}
+	}
+	if p.GRPC != nil {
+		proto.Grpc = &scalehandlerv1.GrpcAction{Port: p.GRPC.Port, Service: p.GRPC.Service}
+	}
+	return proto
+}
+
+func probeProtoToDTO(proto *scalehandlerv1.Probe) *ProbeDTO {
+	if proto == nil {
+		return nil
+	}
+	p := &ProbeDTO{
+		InitialDelaySeconds: proto.InitialDelaySeconds,
+		PeriodSeconds:       proto.PeriodSeconds,
+		TimeoutSeconds:      proto.TimeoutSeconds,
+		FailureThreshold:    proto.FailureThreshold,
+		SuccessThreshold:    proto.SuccessThreshold,
+		HTTPGet:             httpGetProtoToDTO(proto.HttpGet),
+		Exec:                execProtoToDTO(proto.Exec),
+	}
+	if proto.TcpSocket != nil {
+		p.TCPSocket = &TCPSocketActionDTO{Port: proto.TcpSocket.Port}
+	}
+	if proto.Grpc != nil {
+		p.GRPC = &GRPCActionDTO{Port: proto.Grpc.Port, Service: proto.Grpc.Service}
+	}
+	return p
+}
+
+func httpGetDTOToProto(h *HTTPGetActionDTO) *scalehandlerv1.HttpGetAction {
+	if h == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.HttpGetAction{Path: h.Path, Port: h.Port, Scheme: h.Scheme}
+	for _, header := range h.HTTPHeaders {
+		proto.HttpHeaders = append(proto.HttpHeaders, &scalehandlerv1.HttpHeader{Name: header.Name, Value: header.Value})
+	}
+	return proto
+}
+
+func httpGetProtoToDTO(proto *scalehandlerv1.HttpGetAction) *HTTPGetActionDTO {
+	if proto == nil {
+		return nil
+	}
+	h := &HTTPGetActionDTO{Path: proto.Path, Port: proto.Port, Scheme: proto.Scheme}
+	for _, header := range proto.HttpHeaders {
+		if header != nil {
+			h.HTTPHeaders = append(h.HTTPHeaders, HTTPHeaderDTO{Name: header.Name, Value: header.Value})
+		}
+	}
+	return h
+}
+
+func execDTOToProto(e *ExecActionDTO) *scalehandlerv1.ExecAction {
+	if e == nil {
+		return nil
+	}
+	return &scalehandlerv1.ExecAction{Command: e.Command}
+}
+
+func execProtoToDTO(proto *scalehandlerv1.ExecAction) *ExecActionDTO {
+	if proto == nil {
+		return nil
+	}
+	return &ExecActionDTO{Command: proto.Command}
+}
+
+func securityContextDTOToProto(sc *SecurityContextDTO) *scalehandlerv1.SecurityContext {
+	if sc == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.SecurityContext{
+		RunAsUser:                sc.RunAsUser,
+		RunAsGroup:               sc.RunAsGroup,
+		RunAsNonRoot:             sc.RunAsNonRoot,
+		ReadOnlyRootFilesystem:   sc.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: sc.AllowPrivilegeEscalation,
+		Privileged:               sc.Privileged,
+	}
+	if sc.Capabilities != nil {
+		proto.Capabilities = &scalehandlerv1.Capabilities{Add: sc.Capabilities.Add, Drop: sc.Capabilities.Drop}
+	}
+	return proto
+}
+
+func securityContextProtoToDTO(proto *scalehandlerv1.SecurityContext) *SecurityContextDTO {
+	if proto == nil {
+		return nil
+	}
+	sc := &SecurityContextDTO{
+		RunAsUser:                proto.RunAsUser,
+		RunAsGroup:               proto.RunAsGroup,
+		RunAsNonRoot:             proto.RunAsNonRoot,
+		ReadOnlyRootFilesystem:   proto.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: proto.AllowPrivilegeEscalation,
+		Privileged:               proto.Privileged,
+	}
+	if proto.Capabilities != nil {
+		sc.Capabilities = &CapabilitiesDTO{Add: proto.Capabilities.Add, Drop: proto.Capabilities.Drop}
+	}
+	return sc
+}
+
+func podSecurityContextDTOToProto(sc *PodSecurityContextDTO) *scalehandlerv1.PodSecurityContext {
+	if sc == nil {
+		return nil
+	}
+	return &scalehandlerv1.PodSecurityContext{
+		RunAsUser:    sc.RunAsUser,
+		RunAsGroup:   sc.RunAsGroup,
+		RunAsNonRoot: sc.RunAsNonRoot,
+		FsGroup:      sc.FSGroup,
+	}
+}
+
+func podSecurityContextProtoToDTO(proto *scalehandlerv1.PodSecurityContext) *PodSecurityContextDTO {
+	if proto == nil {
+		return nil
+	}
+	return &PodSecurityContextDTO{
+		RunAsUser:    proto.RunAsUser,
+		RunAsGroup:   proto.RunAsGroup,
+		RunAsNonRoot: proto.RunAsNonRoot,
+		FSGroup:      proto.FsGroup,
+	}
+}
+
+func lifecycleDTOToProto(l *LifecycleDTO) *scalehandlerv1.Lifecycle {
+	if l == nil {
+		return nil
+	}
+	return &scalehandlerv1.Lifecycle{
+		PostStart: lifecycleHandlerDTOToProto(l.PostStart),
+		PreStop:   lifecycleHandlerDTOToProto(l.PreStop),
+	}
+}
+
+func lifecycleProtoToDTO(proto *scalehandlerv1.Lifecycle) *LifecycleDTO {
+	if proto == nil {
+		return nil
+	}
+	return &LifecycleDTO{
+		PostStart: lifecycleHandlerProtoToDTO(proto.PostStart),
+		PreStop:   lifecycleHandlerProtoToDTO(proto.PreStop),
+	}
+}
+
+func lifecycleHandlerDTOToProto(h *LifecycleHandlerDTO) *scalehandlerv1.LifecycleHandler {
+	if h == nil {
+		return nil
+	}
+	return &scalehandlerv1.LifecycleHandler{Exec: execDTOToProto(h.Exec), HttpGet: httpGetDTOToProto(h.HTTPGet)}
+}
+
+func lifecycleHandlerProtoToDTO(proto *scalehandlerv1.LifecycleHandler) *LifecycleHandlerDTO {
+	if proto == nil {
+		return nil
+	}
+	return &LifecycleHandlerDTO{Exec: execProtoToDTO(proto.Exec), HTTPGet: httpGetProtoToDTO(proto.HttpGet)}
+}
+
+func triggersDTOToProto(triggers []MetricTriggerDTO) []*scalehandlerv1.MetricTrigger {
+	if len(triggers) == 0 {
+		return nil
+	}
+	result := make([]*scalehandlerv1.MetricTrigger, len(triggers))
+	for i, t := range triggers {
+		result[i] = &scalehandlerv1.MetricTrigger{
+			Type:       t.Type,
+			Name:       t.Name,
+			MetricType: t.MetricType,
+			Metadata:   t.Metadata,
+		}
+		if t.AuthenticationRef != nil {
+			result[i].AuthenticationRef = &scalehandlerv1.AuthenticationRef{
+				Name: t.AuthenticationRef.Name,
+				Kind: t.AuthenticationRef.Kind,
+			}
+		}
+	}
+	return result
+}
+
+func triggersProtoToDTO(triggers []*scalehandlerv1.MetricTrigger) []MetricTriggerDTO {
+	var result []MetricTriggerDTO
+	for _, t := range triggers {
+		if t == nil {
+			continue
+		}
+		trigger := MetricTriggerDTO{
+			Type:       t.Type,
+			Name:       t.Name,
+			MetricType: t.MetricType,
+			Metadata:   t.Metadata,
+		}
+		if t.AuthenticationRef != nil {
+			trigger.AuthenticationRef = &AuthenticationRefDTO{
+				Name: t.AuthenticationRef.Name,
+				Kind: t.AuthenticationRef.Kind,
+			}
+		}
+		result = append(result, trigger)
+	}
+	return result
 }
@@ -8,9 +8,122 @@ type CreateScheduleRequestDTO struct {
 
 // ScheduleDTO - расписание масштабирования
 type ScheduleDTO struct {
-	Weekdays   map[string][]TimeRangeDTO `json:"weekdays"`
-	Dates      map[string][]TimeRangeDTO `json:"dates"`
-	Exceptions []string                  `json:"exceptions"`
+	Weekdays        map[string][]TimeRangeDTO `json:"weekdays"`
+	Dates           map[string][]TimeRangeDTO `json:"dates"`
+	Exceptions      []string                  `json:"exceptions"`
+	Timezone        string                    `json:"timezone,omitempty"`  // IANA, например Europe/Berlin
+	Namespace       string                    `json:"namespace,omitempty"` // пусто = namespace по умолчанию
+	Scaling         *ScalingOptionsDTO        `json:"scaling,omitempty"`
+	DefaultReplicas int32                     `json:"defaultReplicas,omitempty"` // реплик вне окон, по умолчанию 0
+	Target          *TargetRefDTO             `json:"target,omitempty"`          // существующий workload вместо application
+	Labels          map[string]string         `json:"labels,omitempty"`          // добавляются ко всем создаваемым объектам
+	Annotations     map[string]string         `json:"annotations,omitempty"`     // добавляются ко всем создаваемым объектам
+	Triggers        []MetricTriggerDTO        `json:"triggers,omitempty"`        // масштабируют выше окон расписания
+}
+
+// TargetRefDTO - workload, развёрнутый вне сервиса (например, через CI)
+type TargetRefDTO struct {
+	APIVersion string `json:"apiVersion,omitempty"` // обязателен для видов кроме Deployment и StatefulSet
+	Kind       string `json:"kind,omitempty"`       // по умолчанию Deployment; любой вид с подресурсом /scale
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
+// ScalingOptionsDTO - параметры ScaledObject KEDA
+type ScalingOptionsDTO struct {
+	MinReplicaCount *int32              `json:"minReplicaCount,omitempty"` // по умолчанию 0
+	MaxReplicaCount *int32              `json:"maxReplicaCount,omitempty"` // по умолчанию 100
+	CooldownPeriod  *int32              `json:"cooldownPeriod,omitempty"`  // по умолчанию 300
+	PollingInterval *int32              `json:"pollingInterval,omitempty"`
+	Fallback        *FallbackDTO        `json:"fallback,omitempty"`
+	Behavior        *ScalingBehaviorDTO `json:"behavior,omitempty"`
+}
+
+type FallbackDTO struct {
+	FailureThreshold int32 `json:"failureThreshold"`
+	Replicas         int32 `json:"replicas"`
+}
+
+type ScalingBehaviorDTO struct {
+	ScaleUp   *ScalingRulesDTO `json:"scaleUp,omitempty"`
+	ScaleDown *ScalingRulesDTO `json:"scaleDown,omitempty"`
+}
+
+type ScalingRulesDTO struct {
+	StabilizationWindowSeconds *int32             `json:"stabilizationWindowSeconds,omitempty"`
+	SelectPolicy               string             `json:"selectPolicy,omitempty"` // Max, Min, Disabled
+	Policies                   []ScalingPolicyDTO `json:"policies,omitempty"`
+}
+
+type ScalingPolicyDTO struct {
+	Type          string `json:"type"` // Pods, Percent
+	Value         int32  `json:"value"`
+	PeriodSeconds int32  `json:"periodSeconds"`
+}
+
+// MetricTriggerDTO - триггер KEDA по метрике. Окна расписания задают нижнюю
+// границу реплик, метрические триггеры масштабируют выше неё.
+type MetricTriggerDTO struct {
+	Type              string                `json:"type"` // cpu, memory, prometheus, rabbitmq, kafka, aws-sqs-queue, redis
+	Name              string                `json:"name,omitempty"`
+	MetricType        string                `json:"metricType,omitempty"` // Utilization, AverageValue, Value
+	Metadata          map[string]string     `json:"metadata"`
+	AuthenticationRef *AuthenticationRefDTO `json:"authenticationRef,omitempty"`
+}
+
+// AuthenticationRefDTO - TriggerAuthentication с учётными данными для триггера
+type AuthenticationRefDTO struct {
+	Name string `json:"name"`
+	Kind string `json:"kind,omitempty"` // TriggerAuthentication (по умолчанию), ClusterTriggerAuthentication
+}
+
+// ScheduleStatusDTO - состояние применения расписания в кластере
+type ScheduleStatusDTO struct {
+	Phase             string         `json:"phase"` // Pending, Applied, Degraded
+	LastAppliedAt     string         `json:"lastAppliedAt,omitempty"`
+	LastError         string         `json:"lastError,omitempty"`
+	AppliedGeneration int64          `json:"appliedGeneration"`
+	Generation        int64          `json:"generation"`
+	DefaultReplicas   int32          `json:"defaultReplicas"` // реплик вне окон
+	MinReplicaCount   int32          `json:"minReplicaCount"` // действующий, не ниже defaultReplicas
+	MaxReplicaCount   int32          `json:"maxReplicaCount"`
+	Pause             *PauseStateDTO `json:"pause,omitempty"` // пусто = расписание активно
+}
+
+// PauseStateDTO - приостановка расписания: KEDA держит workload на replicas
+type PauseStateDTO struct {
+	Replicas int32  `json:"replicas"`
+	PausedBy string `json:"pausedBy"`
+	Reason   string `json:"reason,omitempty"`
+	PausedAt string `json:"pausedAt"` // RFC 3339
+}
+
+// OverrideDTO - временное число реплик поверх окон расписания
+type OverrideDTO struct {
+	ID        string `json:"id"`
+	Replicas  int32  `json:"replicas"`
+	Start     string `json:"start"` // RFC 3339
+	End       string `json:"end"`   // RFC 3339
+	Reason    string `json:"reason,omitempty"`
+	CreatedAt string `json:"createdAt"`
+	Active    bool   `json:"active"`
+}
+
+// OrphanedResourceDTO - объект кластера с меткой сервиса без расписания
+type OrphanedResourceDTO struct {
+	ScheduleID string `json:"scheduleId"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace"`
+	Name       string `json:"name"`
+	OrphanedAt string `json:"orphanedAt,omitempty"` // RFC 3339, пусто = ещё не помечен
+	Action     string `json:"action,omitempty"`     // marked, deleted
+}
+
+// GCReportDTO - итог прохода сборки мусора
+type GCReportDTO struct {
+	Checked int32                 `json:"checked"`
+	Orphans []OrphanedResourceDTO `json:"orphans"`
+	Errors  []string              `json:"errors,omitempty"`
 }
 
 type TimeRangeDTO struct {
@@ -21,27 +134,274 @@ type TimeRangeDTO struct {
 
 // ApplicationDTO - контейнеры для Deployment
 type ApplicationDTO struct {
-	Containers []ContainerDTO `json:"containers"`
+	Kind       string          `json:"kind,omitempty"` // Deployment (по умолчанию), StatefulSet
+	Containers []ContainerDTO  `json:"containers"`
+	Service    *ServiceSpecDTO `json:"service,omitempty"`
+	Ingress    *IngressSpecDTO `json:"ingress,omitempty"` // требует service
+	Volumes    []VolumeDTO     `json:"volumes,omitempty"`
+
+	// Размещение подов
+	NodeSelector              map[string]string             `json:"nodeSelector,omitempty"`
+	Tolerations               []TolerationDTO               `json:"tolerations,omitempty"`
+	Affinity                  *AffinityDTO                  `json:"affinity,omitempty"`
+	TopologySpreadConstraints []TopologySpreadConstraintDTO `json:"topologySpreadConstraints,omitempty"`
+	PriorityClassName         string                        `json:"priorityClassName,omitempty"`
+
+	// Параметры пода
+	InitContainers                []ContainerDTO         `json:"initContainers,omitempty"`
+	ImagePullSecrets              []string               `json:"imagePullSecrets,omitempty"`
+	ServiceAccountName            string                 `json:"serviceAccountName,omitempty"`
+	TerminationGracePeriodSeconds *int64                 `json:"terminationGracePeriodSeconds,omitempty"`
+	SecurityContext               *PodSecurityContextDTO `json:"securityContext,omitempty"`
+}
+
+// PodSecurityContextDTO - настройки безопасности для всех контейнеров пода
+type PodSecurityContextDTO struct {
+	RunAsUser    *int64 `json:"runAsUser,omitempty"`
+	RunAsGroup   *int64 `json:"runAsGroup,omitempty"`
+	RunAsNonRoot *bool  `json:"runAsNonRoot,omitempty"`
+	FSGroup      *int64 `json:"fsGroup,omitempty"`
+}
+
+type TolerationDTO struct {
+	Key               string `json:"key,omitempty"`
+	Operator          string `json:"operator,omitempty"` // Equal (по умолчанию) или Exists
+	Value             string `json:"value,omitempty"`
+	Effect            string `json:"effect,omitempty"` // NoSchedule, PreferNoSchedule, NoExecute
+	TolerationSeconds *int64 `json:"tolerationSeconds,omitempty"`
+}
+
+type AffinityDTO struct {
+	NodeAffinity    *NodeAffinityDTO `json:"nodeAffinity,omitempty"`
+	PodAffinity     *PodAffinityDTO  `json:"podAffinity,omitempty"`
+	PodAntiAffinity *PodAffinityDTO  `json:"podAntiAffinity,omitempty"`
+}
+
+// NodeAffinityDTO - required обязателен для размещения (термы объединяются
+// через ИЛИ), preferred лишь повышает приоритет узлов
+type NodeAffinityDTO struct {
+	Required  []NodeSelectorTermDTO        `json:"required,omitempty"`
+	Preferred []PreferredSchedulingTermDTO `json:"preferred,omitempty"`
+}
+
+type NodeSelectorTermDTO struct {
+	MatchExpressions []SelectorRequirementDTO `json:"matchExpressions"`
+}
+
+type PreferredSchedulingTermDTO struct {
+	Weight     int32               `json:"weight"` // 1-100
+	Preference NodeSelectorTermDTO `json:"preference"`
+}
+
+// SelectorRequirementDTO - условие на метку узла или пода
+type SelectorRequirementDTO struct {
+	Key      string   `json:"key"`
+	Operator string   `json:"operator"` // In, NotIn, Exists, DoesNotExist, а для узлов ещё Gt, Lt
+	Values   []string `json:"values,omitempty"`
+}
+
+type PodAffinityDTO struct {
+	Required  []PodAffinityTermDTO         `json:"required,omitempty"`
+	Preferred []WeightedPodAffinityTermDTO `json:"preferred,omitempty"`
+}
+
+// PodAffinityTermDTO - без labelSelector выбираются поды самого приложения
+type PodAffinityTermDTO struct {
+	LabelSelector *LabelSelectorDTO `json:"labelSelector,omitempty"`
+	TopologyKey   string            `json:"topologyKey"`
+	Namespaces    []string          `json:"namespaces,omitempty"`
+}
+
+type WeightedPodAffinityTermDTO struct {
+	Weight int32              `json:"weight"` // 1-100
+	Term   PodAffinityTermDTO `json:"term"`
+}
+
+type LabelSelectorDTO struct {
+	MatchLabels      map[string]string        `json:"matchLabels,omitempty"`
+	MatchExpressions []SelectorRequirementDTO `json:"matchExpressions,omitempty"`
+}
+
+// TopologySpreadConstraintDTO - без labelSelector распределяются поды самого
+// приложения
+type TopologySpreadConstraintDTO struct {
+	MaxSkew           int32             `json:"maxSkew"`
+	TopologyKey       string            `json:"topologyKey"`                 // например topology.kubernetes.io/zone
+	WhenUnsatisfiable string            `json:"whenUnsatisfiable,omitempty"` // DoNotSchedule (по умолчанию) или ScheduleAnyway
+	LabelSelector     *LabelSelectorDTO `json:"labelSelector,omitempty"`
+	MinDomains        int32             `json:"minDomains,omitempty"`
+}
+
+// ServiceSpecDTO - Service перед приложением; без ports публикуются все
+// порты контейнеров
+type ServiceSpecDTO struct {
+	Type  string           `json:"type,omitempty"` // ClusterIP (по умолчанию), NodePort, LoadBalancer
+	Ports []ServicePortDTO `json:"ports,omitempty"`
+}
+
+type ServicePortDTO struct {
+	Name       string `json:"name,omitempty"`
+	Port       int32  `json:"port"`
+	TargetPort int32  `json:"targetPort,omitempty"` // по умолчанию равен port
+	Protocol   string `json:"protocol,omitempty"`   // TCP, UDP
+}
+
+// IngressSpecDTO - HTTP-маршрут снаружи кластера к Service
+type IngressSpecDTO struct {
+	ClassName     string `json:"className,omitempty"`
+	Host          string `json:"host"`
+	Path          string `json:"path,omitempty"`        // по умолчанию "/"
+	PathType      string `json:"pathType,omitempty"`    // Prefix (по умолчанию), Exact, ImplementationSpecific
+	ServicePort   int32  `json:"servicePort,omitempty"` // по умолчанию первый порт Service
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
 }
 
 type ContainerDTO struct {
-	Name      string            `json:"name"`
-	Image     string            `json:"image"`
-	Ports     []ContainerPortDTO `json:"ports,omitempty"`
-	Env       []EnvVarDTO       `json:"env,omitempty"`
-	Resources *ResourcesDTO     `json:"resources,omitempty"`
-	LivenessProbe  *ProbeDTO `json:"livenessProbe,omitempty"`
-	ReadinessProbe *ProbeDTO `json:"readinessProbe,omitempty"`
+	Name            string              `json:"name"`
+	Image           string              `json:"image"`
+	Command         []string            `json:"command,omitempty"`         // заменяет ENTRYPOINT образа
+	Args            []string            `json:"args,omitempty"`            // заменяет CMD образа
+	ImagePullPolicy string              `json:"imagePullPolicy,omitempty"` // Always, IfNotPresent, Never
+	SecurityContext *SecurityContextDTO `json:"securityContext,omitempty"`
+	Lifecycle       *LifecycleDTO       `json:"lifecycle,omitempty"`
+	Ports           []ContainerPortDTO  `json:"ports,omitempty"`
+	Env             []EnvVarDTO         `json:"env,omitempty"`
+	EnvFrom         []EnvFromSourceDTO  `json:"envFrom,omitempty"`
+	Resources       *ResourcesDTO       `json:"resources,omitempty"`
+	LivenessProbe   *ProbeDTO           `json:"livenessProbe,omitempty"`
+	ReadinessProbe  *ProbeDTO           `json:"readinessProbe,omitempty"`
+	StartupProbe    *ProbeDTO           `json:"startupProbe,omitempty"`
+	VolumeMounts    []VolumeMountDTO    `json:"volumeMounts,omitempty"`
+}
+
+type SecurityContextDTO struct {
+	RunAsUser                *int64           `json:"runAsUser,omitempty"`
+	RunAsGroup               *int64           `json:"runAsGroup,omitempty"`
+	RunAsNonRoot             *bool            `json:"runAsNonRoot,omitempty"`
+	ReadOnlyRootFilesystem   *bool            `json:"readOnlyRootFilesystem,omitempty"`
+	AllowPrivilegeEscalation *bool            `json:"allowPrivilegeEscalation,omitempty"`
+	Privileged               *bool            `json:"privileged,omitempty"`
+	Capabilities             *CapabilitiesDTO `json:"capabilities,omitempty"`
+}
+
+type CapabilitiesDTO struct {
+	Add  []string `json:"add,omitempty"`
+	Drop []string `json:"drop,omitempty"`
+}
+
+// LifecycleDTO - хуки контейнера; preStop позволяет завершить запросы
+// перед остановкой пода
+type LifecycleDTO struct {
+	PostStart *LifecycleHandlerDTO `json:"postStart,omitempty"`
+	PreStop   *LifecycleHandlerDTO `json:"preStop,omitempty"`
+}
+
+// LifecycleHandlerDTO - задаётся ровно одно из exec и httpGet
+type LifecycleHandlerDTO struct {
+	Exec    *ExecActionDTO    `json:"exec,omitempty"`
+	HTTPGet *HTTPGetActionDTO `json:"httpGet,omitempty"`
 }
 
 type ContainerPortDTO struct {
 	ContainerPort int32  `json:"containerPort"`
-	Protocol     string `json:"protocol,omitempty"` // TCP, UDP
+	Protocol      string `json:"protocol,omitempty"` // TCP, UDP
 }
 
+// EnvVarDTO - переменная окружения; задаётся либо value, либо valueFrom
 type EnvVarDTO struct {
-	Name  string `json:"name"`
-	Value string `json:"value"`
+	Name      string           `json:"name"`
+	Value     string           `json:"value,omitempty"`
+	ValueFrom *EnvVarSourceDTO `json:"valueFrom,omitempty"`
+}
+
+// EnvVarSourceDTO - источник значения, задаётся ровно одно поле
+type EnvVarSourceDTO struct {
+	SecretKeyRef    *KeySelectorDTO `json:"secretKeyRef,omitempty"`
+	ConfigMapKeyRef *KeySelectorDTO `json:"configMapKeyRef,omitempty"`
+	FieldRef        *FieldRefDTO    `json:"fieldRef,omitempty"`
+}
+
+// KeySelectorDTO - ключ Secret или ConfigMap из namespace расписания
+type KeySelectorDTO struct {
+	Name     string `json:"name"`
+	Key      string `json:"key"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+type FieldRefDTO struct {
+	FieldPath string `json:"fieldPath"` // например metadata.name, status.podIP
+}
+
+// EnvFromSourceDTO подставляет все ключи Secret или ConfigMap
+type EnvFromSourceDTO struct {
+	Prefix       string             `json:"prefix,omitempty"`
+	SecretRef    *LocalObjectRefDTO `json:"secretRef,omitempty"`
+	ConfigMapRef *LocalObjectRefDTO `json:"configMapRef,omitempty"`
+}
+
+type LocalObjectRefDTO struct {
+	Name     string `json:"name"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// VolumeDTO - том пода, задаётся ровно один источник
+type VolumeDTO struct {
+	Name                  string                    `json:"name"`
+	EmptyDir              *EmptyDirVolumeDTO        `json:"emptyDir,omitempty"`
+	ConfigMap             *ObjectVolumeDTO          `json:"configMap,omitempty"`
+	Secret                *ObjectVolumeDTO          `json:"secret,omitempty"`
+	PersistentVolumeClaim *PersistentVolumeClaimDTO `json:"persistentVolumeClaim,omitempty"`
+	Projected             *ProjectedVolumeDTO       `json:"projected,omitempty"`
+}
+
+type EmptyDirVolumeDTO struct {
+	Medium    string `json:"medium,omitempty"`    // "" или Memory
+	SizeLimit string `json:"sizeLimit,omitempty"` // например 1Gi
+}
+
+// ObjectVolumeDTO - ConfigMap или Secret в виде файлов; без items
+// монтируются все ключи
+type ObjectVolumeDTO struct {
+	Name        string         `json:"name"`
+	Items       []KeyToPathDTO `json:"items,omitempty"`
+	DefaultMode int32          `json:"defaultMode,omitempty"`
+	Optional    bool           `json:"optional,omitempty"`
+}
+
+type KeyToPathDTO struct {
+	Key  string `json:"key"`
+	Path string `json:"path"`
+	Mode int32  `json:"mode,omitempty"`
+}
+
+type PersistentVolumeClaimDTO struct {
+	ClaimName string `json:"claimName"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+type ProjectedVolumeDTO struct {
+	Sources     []VolumeProjectionDTO `json:"sources"`
+	DefaultMode int32                 `json:"defaultMode,omitempty"`
+}
+
+// VolumeProjectionDTO - источник projected-тома, задаётся ровно одно поле
+type VolumeProjectionDTO struct {
+	ConfigMap           *ObjectVolumeDTO                  `json:"configMap,omitempty"`
+	Secret              *ObjectVolumeDTO                  `json:"secret,omitempty"`
+	ServiceAccountToken *ServiceAccountTokenProjectionDTO `json:"serviceAccountToken,omitempty"`
+}
+
+type ServiceAccountTokenProjectionDTO struct {
+	Audience          string `json:"audience,omitempty"`
+	ExpirationSeconds int64  `json:"expirationSeconds,omitempty"`
+	Path              string `json:"path"`
+}
+
+type VolumeMountDTO struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+	SubPath   string `json:"subPath,omitempty"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
 }
 
 type ResourcesDTO struct {
@@ -54,13 +414,41 @@ type ResourceQuantityDTO struct {
 	CPU    string `json:"cpu,omitempty"`
 }
 
+// ProbeDTO - проверка контейнера, задаётся ровно одно из httpGet,
+// tcpSocket, exec и grpc
 type ProbeDTO struct {
-	HTTPGet             *HTTPGetActionDTO `json:"httpGet,omitempty"`
-	InitialDelaySeconds  int32             `json:"initialDelaySeconds,omitempty"`
-	PeriodSeconds        int32             `json:"periodSeconds,omitempty"`
+	HTTPGet             *HTTPGetActionDTO   `json:"httpGet,omitempty"`
+	TCPSocket           *TCPSocketActionDTO `json:"tcpSocket,omitempty"`
+	Exec                *ExecActionDTO      `json:"exec,omitempty"`
+	GRPC                *GRPCActionDTO      `json:"grpc,omitempty"`
+	InitialDelaySeconds int32               `json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       int32               `json:"periodSeconds,omitempty"`
+	TimeoutSeconds      int32               `json:"timeoutSeconds,omitempty"`
+	FailureThreshold    int32               `json:"failureThreshold,omitempty"`
+	SuccessThreshold    int32               `json:"successThreshold,omitempty"` // для liveness и startup только 1
 }
 
 type HTTPGetActionDTO struct {
-	Path string `json:"path"`
-	Port int32  `json:"port"`
+	Path        string          `json:"path"`
+	Port        int32           `json:"port"`
+	Scheme      string          `json:"scheme,omitempty"` // HTTP (по умолчанию) или HTTPS
+	HTTPHeaders []HTTPHeaderDTO `json:"httpHeaders,omitempty"`
+}
+
+type HTTPHeaderDTO struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type TCPSocketActionDTO struct {
+	Port int32 `json:"port"`
+}
+
+type ExecActionDTO struct {
+	Command []string `json:"command"`
+}
+
+type GRPCActionDTO struct {
+	Port    int32  `json:"port"`
+	Service string `json:"service,omitempty"`
 }
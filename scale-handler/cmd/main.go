@@ -12,8 +12,12 @@ import (
 	"scale-handler/internal/app"
 	"scale-handler/internal/config"
 	"scale-handler/internal/controller"
+	"scale-handler/internal/gc"
 	"scale-handler/internal/k8s"
+	"scale-handler/internal/reconciler"
+	"scale-handler/internal/reconciler/memory"
 	"scale-handler/internal/repository/postgres"
+	"scale-handler/internal/resync"
 	"scale-handler/internal/usecase"
 
 	"github.com/jmoiron/sqlx"
@@ -53,20 +57,23 @@ func main() {
 	}
 	logger.Info("Database check passed, table exists")
 
-	scheduleUC := usecase.NewScheduleUseCase(scheduleRepo, logger)
+	overrideRepo := postgres.NewOverrideRepository(db, logger)
 
-	var k8sReconciler *k8s.Reconciler
-	if cfg.Kubeconfig != "" {
-		var err error
-		k8sReconciler, err = k8s.NewReconciler(cfg.Kubeconfig, logger)
-		if err != nil {
-			logger.Warn("K8s reconciler disabled", "error", err)
-		} else {
-			logger.Info("K8s reconciler enabled", "kubeconfig", cfg.Kubeconfig)
-		}
+	scheduleUC := usecase.NewScheduleUseCase(scheduleRepo, overrideRepo, cfg.K8s, logger)
+
+	scalingBackend, err := newScalingBackend(cfg, logger)
+	if err != nil {
+		logger.Error("Failed to create scaling backend", "backend", cfg.Backend, "error", err)
+		os.Exit(1)
 	}
 
-	ctrl := controller.NewController(scheduleUC, k8sReconciler, logger)
+	// Сборка мусора есть не у каждого бэкенда
+	var collector *gc.Collector
+	if backend, ok := scalingBackend.(reconciler.GarbageCollector); ok {
+		collector = gc.NewCollector(scheduleUC, backend, cfg.GC.GracePeriod, logger)
+	}
+
+	ctrl := controller.NewController(scheduleUC, scalingBackend, collector, logger)
 
 	// Создаем gRPC сервер
 	grpcServer, err := app.NewGRPCServer(cfg.GRPCPort, ctrl, logger)
@@ -83,6 +90,17 @@ func main() {
 		}
 	}()
 
+	// Запускаем периодическую сверку с кластером
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	worker := resync.NewWorker(scheduleUC, scalingBackend, cfg.ResyncInterval, logger)
+	go worker.Run(workerCtx)
+
+	// Запускаем поиск объектов, оставшихся без расписания
+	if collector != nil && cfg.GC.Interval > 0 {
+		go collector.Run(workerCtx, cfg.GC.Interval, cfg.GC.DryRun)
+	}
+
 	logger.Info("Scale-handler service started", "grpc_port", cfg.GRPCPort)
 
 	// Ожидаем сигнал завершения
@@ -93,10 +111,32 @@ func main() {
 	logger.Info("Shutting down service...")
 
 	// Graceful shutdown
+	stopWorkers()
 	grpcServer.Stop()
 	logger.Info("Service stopped gracefully")
 }
 
+// newScalingBackend создаёт выбранный бэкенд масштабирования. Без
+// KUBECONFIG KEDA-бэкенд подключается к кластеру изнутри пода.
+func newScalingBackend(cfg *config.Config, logger *slog.Logger) (reconciler.Reconciler, error) {
+	switch cfg.Backend {
+	case config.BackendMemory:
+		backend, err := memory.NewReconciler(cfg.K8s, logger)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("In-memory scaling backend enabled")
+		return backend, nil
+	default:
+		backend, err := k8s.NewReconciler(cfg.Kubeconfig, cfg.K8s, logger)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("K8s reconciler enabled", "kubeconfig", cfg.Kubeconfig)
+		return backend, nil
+	}
+}
+
 func setupLogger() *slog.Logger {
 	return slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelInfo,
@@ -1,16 +1,29 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// Бэкенды масштабирования
+const (
+	BackendKEDA   = "keda"   // ScaledObject в кластере
+	BackendMemory = "memory" // состояние в памяти процесса, для тестов и демо
+)
+
 type Config struct {
-	GRPCPort   string
-	Kubeconfig string // путь к kubeconfig, пусто = in-cluster
-	Database   DatabaseConfig
+	GRPCPort       string
+	Backend        string        // бэкенд масштабирования
+	Kubeconfig     string        // путь к kubeconfig, пусто = in-cluster
+	ResyncInterval time.Duration // период сверки с кластером, обязателен
+	GC             GCConfig
+	Database       DatabaseConfig
+	K8s            K8sConfig
 }
 
 type DatabaseConfig struct {
@@ -22,12 +35,33 @@ type DatabaseConfig struct {
 	SSLMode  string
 }
 
+type GCConfig struct {
+	Interval    time.Duration // период поиска осиротевших объектов, 0 = выключен
+	GracePeriod time.Duration // сколько объект живёт после обнаружения
+	DryRun      bool          // только сообщать, ничего не удалять; по умолчанию включён
+}
+
+type K8sConfig struct {
+	DefaultTimezone   string            // IANA, используется если в расписании не задана таймзона
+	DefaultNamespace  string            // namespace, если в расписании он не указан
+	AllowedNamespaces []string          // пусто = любой namespace
+	CreateNamespaces  bool              // создавать отсутствующий namespace
+	NamespaceLabels   map[string]string // метки для создаваемых namespace
+}
+
 func Load() (*Config, error) {
 	_ = godotenv.Load() // Игнорируем ошибку если .env нет
 
-	return &Config{
-		GRPCPort:   getEnv("GRPC_PORT", "50051"),
-		Kubeconfig: getEnv("KUBECONFIG", ""), // ~/.kube/config для minikube
+	cfg := &Config{
+		GRPCPort:       getEnv("GRPC_PORT", "50051"),
+		Backend:        getEnv("SCALING_BACKEND", BackendKEDA),
+		Kubeconfig:     getEnv("KUBECONFIG", ""), // ~/.kube/config для minikube
+		ResyncInterval: getEnvAsDuration("RESYNC_INTERVAL", 5*time.Minute),
+		GC: GCConfig{
+			Interval:    getEnvAsDuration("GC_INTERVAL", time.Hour),
+			GracePeriod: getEnvAsDuration("GC_GRACE_PERIOD", 24*time.Hour),
+			DryRun:      getEnvAsBool("GC_DRY_RUN", true),
+		},
 		Database: DatabaseConfig{
 			Host:     getEnv("DB_HOST", "localhost"),
 			Port:     getEnvAsInt("DB_PORT", 5432),
@@ -36,7 +70,40 @@ func Load() (*Config, error) {
 			DBName:   getEnv("DB_NAME", "scale_handler"),
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
 		},
-	}, nil
+		K8s: K8sConfig{
+			DefaultTimezone:   getEnv("DEFAULT_TIMEZONE", "Europe/Moscow"),
+			DefaultNamespace:  getEnv("DEFAULT_NAMESPACE", "default"),
+			AllowedNamespaces: getEnvAsList("ALLOWED_NAMESPACES"),
+			CreateNamespaces:  getEnvAsBool("CREATE_NAMESPACES", false),
+			NamespaceLabels:   getEnvAsMap("NAMESPACE_LABELS"),
+		},
+	}
+
+	// Cron не знает года: прошедшие даты и истёкшие переопределения сработали
+	// бы снова через год, а убирает их из ScaledObject только сверка
+	if cfg.ResyncInterval <= 0 {
+		return nil, fmt.Errorf("RESYNC_INTERVAL must be positive: %s", cfg.ResyncInterval)
+	}
+
+	if cfg.Backend != BackendKEDA && cfg.Backend != BackendMemory {
+		return nil, fmt.Errorf("invalid SCALING_BACKEND %q: expected %s or %s", cfg.Backend, BackendKEDA, BackendMemory)
+	}
+
+	if !IsIANATimezone(cfg.K8s.DefaultTimezone) {
+		return nil, fmt.Errorf("invalid DEFAULT_TIMEZONE %q: expected IANA timezone name", cfg.K8s.DefaultTimezone)
+	}
+
+	return cfg, nil
+}
+
+// IsIANATimezone сообщает, что name - зона из базы IANA. "Local"
+// time.LoadLocation тоже принимает, но в KEDA это была бы зона пода.
+func IsIANATimezone(name string) bool {
+	if name == "Local" {
+		return false
+	}
+	loc, err := time.LoadLocation(name)
+	return err == nil && loc.String() == name
 }
 
 func getEnv(key, defaultValue string) string {
@@ -54,3 +121,43 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return defaultValue
 }
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsDuration разбирает длительность вида "30s", "5m"
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if duration, err := time.ParseDuration(value); err == nil {
+			return duration
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsList разбирает список вида "a,b,c"
+func getEnvAsList(key string) []string {
+	var result []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// getEnvAsMap разбирает пары вида "key1=value1,key2=value2"
+func getEnvAsMap(key string) map[string]string {
+	result := map[string]string{}
+	for _, item := range getEnvAsList(key) {
+		k, v, _ := strings.Cut(item, "=")
+		result[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return result
+}
@@ -1,26 +1,68 @@
 package controller
 
 import (
+	"context"
+	"errors"
 	"log/slog"
 
-	"scale-handler/internal/k8s"
+	"scale-handler/internal/domain"
+	"scale-handler/internal/gc"
+	"scale-handler/internal/reconciler"
 	"scale-handler/internal/usecase"
 	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ scalehandlerv1.ScaleHandlerServiceServer = (*Controller)(nil)
 
 type Controller struct {
 	scalehandlerv1.UnimplementedScaleHandlerServiceServer
-	scheduleUC    *usecase.ScheduleUseCase
-	k8sReconciler *k8s.Reconciler
-	logger        *slog.Logger
+	scheduleUC *usecase.ScheduleUseCase
+	reconciler reconciler.Reconciler
+	collector  *gc.Collector // nil - бэкенд без сборки мусора
+	logger     *slog.Logger
 }
 
-func NewController(scheduleUC *usecase.ScheduleUseCase, k8sReconciler *k8s.Reconciler, logger *slog.Logger) *Controller {
+func NewController(scheduleUC *usecase.ScheduleUseCase, reconciler reconciler.Reconciler, collector *gc.Collector, logger *slog.Logger) *Controller {
 	return &Controller{
-		scheduleUC:    scheduleUC,
-		k8sReconciler: k8sReconciler,
-		logger:        logger,
+		scheduleUC: scheduleUC,
+		reconciler: reconciler,
+		collector:  collector,
+		logger:     logger,
+	}
+}
+
+// toStatusError переводит доменные ошибки в коды gRPC
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return err
+	}
+}
+
+// recordApplyResult сохраняет статус применения; ошибка сохранения не
+// должна ломать ответ клиенту
+func (c *Controller) recordApplyResult(ctx context.Context, schedule *domain.Schedule, applyErr error) {
+	if err := c.scheduleUC.RecordApplyResult(ctx, schedule, applyErr); err != nil {
+		c.logger.Error("Failed to save schedule status", "id", schedule.ID, "error", err)
+	}
+}
+
+// reapplyResources переносит паузу или переопределения на ScaledObject.
+// Ошибка попадает в статус расписания, а изменение остаётся сохранённым и
+// применится при сверке.
+func (c *Controller) reapplyResources(ctx context.Context, schedule *domain.Schedule) {
+	err := c.reconciler.UpdateResources(ctx, schedule)
+	if err != nil {
+		c.logger.Error("Failed to update K8s resources", "id", schedule.ID, "error", err)
 	}
+	c.recordApplyResult(ctx, schedule, err)
 }
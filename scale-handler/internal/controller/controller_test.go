@@ -0,0 +1,386 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"sort"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"scale-handler/internal/config"
+	"scale-handler/internal/domain"
+	"scale-handler/internal/reconciler/memory"
+	"scale-handler/internal/usecase"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+// scheduleRepo и overrideRepo хранят расписания в памяти так же, как
+// postgres-репозитории: generation растёт при изменении и паузе, а
+// расписание читается вместе с неистёкшими переопределениями
+type scheduleRepo struct {
+	schedules map[string]*domain.Schedule
+	overrides *overrideRepo
+	seq       int
+}
+
+func (r *scheduleRepo) Create(ctx context.Context, schedule *domain.Schedule) (*domain.Schedule, error) {
+	r.seq++
+	stored := *schedule
+	stored.ID, stored.Generation = fmt.Sprintf("s%d", r.seq), 1
+	stored.Status = domain.ScheduleStatus{Phase: domain.PhasePending}
+	r.schedules[stored.ID] = &stored
+	return r.GetByID(ctx, stored.ID)
+}
+
+func (r *scheduleRepo) GetByID(ctx context.Context, id string) (*domain.Schedule, error) {
+	stored, ok := r.schedules[id]
+	if !ok {
+		return nil, fmt.Errorf("schedule %s: %w", id, domain.ErrNotFound)
+	}
+	schedule := *stored
+	schedule.Overrides = nil
+	for _, o := range r.overrides.items {
+		if o.ScheduleID == id && o.End.After(time.Now()) {
+			schedule.Overrides = append(schedule.Overrides, o)
+		}
+	}
+	return &schedule, nil
+}
+
+func (r *scheduleRepo) List(ctx context.Context) ([]*domain.Schedule, error) {
+	var result []*domain.Schedule
+	for id := range r.schedules {
+		schedule, _ := r.GetByID(ctx, id)
+		result = append(result, schedule)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+	return result, nil
+}
+
+func (r *scheduleRepo) Update(ctx context.Context, schedule *domain.Schedule) (*domain.Schedule, error) {
+	stored, ok := r.schedules[schedule.ID]
+	if !ok {
+		return nil, fmt.Errorf("schedule %s: %w", schedule.ID, domain.ErrNotFound)
+	}
+	updated := *schedule
+	updated.Generation = stored.Generation + 1
+	updated.Pause, updated.Status = stored.Pause, stored.Status
+	updated.Status.Phase = domain.PhasePending
+	r.schedules[schedule.ID] = &updated
+	return r.GetByID(ctx, schedule.ID)
+}
+
+func (r *scheduleRepo) Delete(ctx context.Context, id string) error {
+	delete(r.schedules, id)
+	return nil
+}
+
+func (r *scheduleRepo) UpdateStatus(ctx context.Context, id string, status domain.ScheduleStatus) error {
+	stored, ok := r.schedules[id]
+	if !ok {
+		return fmt.Errorf("schedule %s: %w", id, domain.ErrNotFound)
+	}
+	stored.Status = status
+	return nil
+}
+
+func (r *scheduleRepo) SetPause(ctx context.Context, id string, pause *domain.PauseState) (*domain.Schedule, error) {
+	stored, ok := r.schedules[id]
+	if !ok {
+		return nil, fmt.Errorf("schedule %s: %w", id, domain.ErrNotFound)
+	}
+	stored.Pause = pause
+	stored.Generation++
+	stored.Status.Phase = domain.PhasePending
+	return r.GetByID(ctx, id)
+}
+
+type overrideRepo struct {
+	items []domain.Override
+}
+
+func (r *overrideRepo) Create(ctx context.Context, override *domain.Override) (*domain.Override, error) {
+	created := *override
+	created.ID, created.CreatedAt = fmt.Sprintf("o%d", len(r.items)+1), time.Now()
+	r.items = append(r.items, created)
+	return &created, nil
+}
+
+func (r *overrideRepo) ListBySchedule(ctx context.Context, scheduleID string) ([]domain.Override, error) {
+	var result []domain.Override
+	for _, o := range r.items {
+		if o.ScheduleID == scheduleID {
+			result = append(result, o)
+		}
+	}
+	return result, nil
+}
+
+func (r *overrideRepo) Delete(ctx context.Context, scheduleID, id string) error {
+	for i, o := range r.items {
+		if o.ScheduleID == scheduleID && o.ID == id {
+			r.items = append(r.items[:i], r.items[i+1:]...)
+			return nil
+		}
+	}
+	return fmt.Errorf("override %s: %w", id, domain.ErrNotFound)
+}
+
+func newTestController(t *testing.T) (*Controller, *scheduleRepo, *memory.Reconciler) {
+	t.Helper()
+	cfg := config.K8sConfig{
+		DefaultTimezone:   "UTC",
+		DefaultNamespace:  "default",
+		AllowedNamespaces: []string{"default", "team-a"},
+	}
+	backend, err := memory.NewReconciler(cfg, slog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	overrides := &overrideRepo{}
+	repo := &scheduleRepo{schedules: map[string]*domain.Schedule{}, overrides: overrides}
+	scheduleUC := usecase.NewScheduleUseCase(repo, overrides, cfg, slog.Default())
+	return NewController(scheduleUC, backend, nil, slog.Default()), repo, backend
+}
+
+func testSchedule(namespace string) *scalehandlerv1.Schedule {
+	return &scalehandlerv1.Schedule{
+		Namespace:       namespace,
+		DefaultReplicas: 1,
+		Weekdays: map[string]*scalehandlerv1.Schedule_DaySchedule{
+			"monday": {TimeRanges: []*scalehandlerv1.TimeRange{{From: "09:00", To: "18:00", Replicas: 3}}},
+		},
+	}
+}
+
+func testApplication() *scalehandlerv1.Application {
+	return &scalehandlerv1.Application{
+		Containers: []*scalehandlerv1.Container{{Name: "web", Image: "nginx:1.27"}},
+	}
+}
+
+// kinds возвращает виды объектов и их namespace в порядке применения
+func kinds(objects []domain.Manifest) []string {
+	result := make([]string, len(objects))
+	for i, obj := range objects {
+		result[i] = obj.Namespace + "/" + obj.Kind
+	}
+	return result
+}
+
+// scaledObject разбирает ScaledObject расписания из памяти бэкенда
+func scaledObject(t *testing.T, backend *memory.Reconciler, id string) map[string]interface{} {
+	t.Helper()
+	for _, obj := range backend.Objects(id) {
+		if obj.Kind != "ScaledObject" {
+			continue
+		}
+		var content map[string]interface{}
+		if err := json.Unmarshal(obj.Content, &content); err != nil {
+			t.Fatal(err)
+		}
+		return content
+	}
+	t.Fatalf("schedule %s has no ScaledObject", id)
+	return nil
+}
+
+func triggerCount(so map[string]interface{}) int {
+	return len(so["spec"].(map[string]interface{})["triggers"].([]interface{}))
+}
+
+func pausedReplicas(so map[string]interface{}) interface{} {
+	annotations, _ := so["metadata"].(map[string]interface{})["annotations"].(map[string]interface{})
+	return annotations["autoscaling.keda.sh/paused-replicas"]
+}
+
+func assertCode(t *testing.T, err error, want codes.Code) {
+	t.Helper()
+	if got := status.Code(err); got != want {
+		t.Fatalf("error = %v, want code %s", err, want)
+	}
+}
+
+func TestCreate(t *testing.T) {
+	ctx := context.Background()
+	c, repo, backend := newTestController(t)
+
+	resp, err := c.Create(ctx, &scalehandlerv1.CreateRequest{Schedule: testSchedule(""), Application: testApplication()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"default/Deployment", "default/ScaledObject"}
+	if got := kinds(backend.Objects(resp.Id)); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("objects = %v, want %v", got, want)
+	}
+	if phase := repo.schedules[resp.Id].Status.Phase; phase != domain.PhaseApplied {
+		t.Errorf("phase = %s, want %s", phase, domain.PhaseApplied)
+	}
+}
+
+func TestCreateNamespaceNotAllowed(t *testing.T) {
+	c, repo, _ := newTestController(t)
+
+	_, err := c.Create(context.Background(), &scalehandlerv1.CreateRequest{Schedule: testSchedule("kube-system"), Application: testApplication()})
+	assertCode(t, err, codes.InvalidArgument)
+	if len(repo.schedules) != 0 {
+		t.Errorf("schedule saved despite error")
+	}
+}
+
+func TestCreateDryRun(t *testing.T) {
+	ctx := context.Background()
+	c, repo, backend := newTestController(t)
+
+	resp, err := c.Create(ctx, &scalehandlerv1.CreateRequest{
+		Schedule:    testSchedule("team-a"),
+		Application: testApplication(),
+		DryRun:      true,
+		Format:      "yaml",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != "" || len(repo.schedules) != 0 {
+		t.Errorf("dry-run saved schedule: id = %q, stored = %d", resp.Id, len(repo.schedules))
+	}
+	if len(resp.Manifests) != 2 || resp.Manifests[1].Kind != "ScaledObject" || resp.Manifests[1].Namespace != "team-a" {
+		t.Errorf("manifests = %v", resp.Manifests)
+	}
+	if managed, _ := backend.ListManaged(ctx); len(managed) != 0 {
+		t.Errorf("dry-run applied %d objects", len(managed))
+	}
+
+	_, err = c.Create(ctx, &scalehandlerv1.CreateRequest{Schedule: testSchedule("kube-system"), Application: testApplication(), DryRun: true})
+	assertCode(t, err, codes.InvalidArgument)
+}
+
+func TestUpdate(t *testing.T) {
+	ctx := context.Background()
+	c, repo, backend := newTestController(t)
+
+	created, err := c.Create(ctx, &scalehandlerv1.CreateRequest{Schedule: testSchedule(""), Application: testApplication()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.Id
+
+	// Dry-run не меняет ни БД, ни бэкенд
+	dry, err := c.Update(ctx, &scalehandlerv1.UpdateRequest{Id: id, Schedule: testSchedule("team-a"), Application: testApplication(), DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dry.Manifests) != 2 || dry.Manifests[0].Namespace != "team-a" {
+		t.Errorf("dry-run manifests = %v", dry.Manifests)
+	}
+	if got := kinds(backend.Objects(id)); got[0] != "default/Deployment" {
+		t.Errorf("dry-run changed objects: %v", got)
+	}
+	if repo.schedules[id].Generation != 1 {
+		t.Errorf("dry-run changed generation: %d", repo.schedules[id].Generation)
+	}
+
+	// Смена namespace переносит объекты
+	if _, err := c.Update(ctx, &scalehandlerv1.UpdateRequest{Id: id, Schedule: testSchedule("team-a"), Application: testApplication()}); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"team-a/Deployment", "team-a/ScaledObject"}
+	if got := kinds(backend.Objects(id)); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("objects = %v, want %v", got, want)
+	}
+	stored := repo.schedules[id]
+	if stored.Status.Phase != domain.PhaseApplied || stored.Status.AppliedGeneration != 2 {
+		t.Errorf("status = %+v, want Applied at generation 2", stored.Status)
+	}
+
+	_, err = c.Update(ctx, &scalehandlerv1.UpdateRequest{Id: id, Schedule: testSchedule("kube-system"), Application: testApplication()})
+	assertCode(t, err, codes.InvalidArgument)
+	_, err = c.Update(ctx, &scalehandlerv1.UpdateRequest{Id: "missing", Schedule: testSchedule(""), Application: testApplication()})
+	assertCode(t, err, codes.NotFound)
+}
+
+func TestPauseResume(t *testing.T) {
+	ctx := context.Background()
+	c, _, backend := newTestController(t)
+
+	created, err := c.Create(ctx, &scalehandlerv1.CreateRequest{Schedule: testSchedule(""), Application: testApplication()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.Id
+
+	// Без явного числа реплик пауза держит текущее: defaultReplicas
+	paused, err := c.Pause(ctx, &scalehandlerv1.PauseRequest{Id: id, PausedBy: "ops"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if paused.Status.Pause.GetReplicas() != 1 {
+		t.Errorf("paused replicas = %d, want 1", paused.Status.Pause.GetReplicas())
+	}
+	if got := pausedReplicas(scaledObject(t, backend, id)); got != "1" {
+		t.Errorf("paused-replicas annotation = %v, want 1", got)
+	}
+
+	replicas := int32(0)
+	if _, err := c.Pause(ctx, &scalehandlerv1.PauseRequest{Id: id, Replicas: &replicas}); err != nil {
+		t.Fatal(err)
+	}
+	if got := pausedReplicas(scaledObject(t, backend, id)); got != "0" {
+		t.Errorf("paused-replicas annotation = %v, want 0", got)
+	}
+
+	resumed, err := c.Resume(ctx, &scalehandlerv1.ResumeRequest{Id: id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed.Status.Pause != nil {
+		t.Errorf("schedule still paused: %v", resumed.Status.Pause)
+	}
+	if got := pausedReplicas(scaledObject(t, backend, id)); got != nil {
+		t.Errorf("paused-replicas annotation = %v after resume", got)
+	}
+
+	_, err = c.Resume(ctx, &scalehandlerv1.ResumeRequest{Id: id})
+	assertCode(t, err, codes.FailedPrecondition)
+}
+
+func TestCreateOverride(t *testing.T) {
+	ctx := context.Background()
+	c, _, backend := newTestController(t)
+
+	created, err := c.Create(ctx, &scalehandlerv1.CreateRequest{Schedule: testSchedule(""), Application: testApplication()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := created.Id
+	before := triggerCount(scaledObject(t, backend, id))
+	end := time.Now().Add(time.Hour).Format(time.RFC3339)
+
+	// Ниже defaultReplicas переопределение ничего бы не изменило
+	_, err = c.CreateOverride(ctx, &scalehandlerv1.CreateOverrideRequest{ScheduleId: id, Replicas: 0, End: end})
+	assertCode(t, err, codes.InvalidArgument)
+
+	resp, err := c.CreateOverride(ctx, &scalehandlerv1.CreateOverrideRequest{ScheduleId: id, Replicas: 5, End: end})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Override.Active {
+		t.Errorf("override is not active")
+	}
+	if got := triggerCount(scaledObject(t, backend, id)); got != before+1 {
+		t.Errorf("triggers = %d, want %d", got, before+1)
+	}
+
+	if _, err := c.CancelOverride(ctx, &scalehandlerv1.CancelOverrideRequest{ScheduleId: id, Id: resp.Override.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if got := triggerCount(scaledObject(t, backend, id)); got != before {
+		t.Errorf("triggers after cancel = %d, want %d", got, before)
+	}
+}
@@ -10,11 +10,29 @@ func ApplicationToProto(app *domain.Application) *scalehandlerv1.Application {
 		return nil
 	}
 	proto := &scalehandlerv1.Application{
+		Kind:       app.Kind,
 		Containers: make([]*scalehandlerv1.Container, len(app.Containers)),
+		Service:    serviceToProto(app.Service),
+		Ingress:    ingressToProto(app.Ingress),
+		Volumes:    volumesToProto(app.Volumes),
+
+		NodeSelector:              app.NodeSelector,
+		Tolerations:               tolerationsToProto(app.Tolerations),
+		Affinity:                  affinityToProto(app.Affinity),
+		TopologySpreadConstraints: topologySpreadToProto(app.TopologySpreadConstraints),
+		PriorityClassName:         app.PriorityClassName,
+
+		ImagePullSecrets:              app.ImagePullSecrets,
+		ServiceAccountName:            app.ServiceAccountName,
+		TerminationGracePeriodSeconds: app.TerminationGracePeriodSeconds,
+		SecurityContext:               podSecurityContextToProto(app.SecurityContext),
 	}
 	for i, c := range app.Containers {
 		proto.Containers[i] = containerToProto(&c)
 	}
+	for _, c := range app.InitContainers {
+		proto.InitContainers = append(proto.InitContainers, containerToProto(&c))
+	}
 	return proto
 }
 
@@ -23,13 +41,33 @@ func ProtoToApplication(proto *scalehandlerv1.Application) *domain.Application {
 		return nil
 	}
 	app := &domain.Application{
+		Kind:       proto.Kind,
 		Containers: make([]domain.Container, len(proto.Containers)),
+		Service:    serviceToDomain(proto.Service),
+		Ingress:    ingressToDomain(proto.Ingress),
+		Volumes:    volumesToDomain(proto.Volumes),
+
+		NodeSelector:              proto.NodeSelector,
+		Tolerations:               tolerationsToDomain(proto.Tolerations),
+		Affinity:                  affinityToDomain(proto.Affinity),
+		TopologySpreadConstraints: topologySpreadToDomain(proto.TopologySpreadConstraints),
+		PriorityClassName:         proto.PriorityClassName,
+
+		ImagePullSecrets:              proto.ImagePullSecrets,
+		ServiceAccountName:            proto.ServiceAccountName,
+		TerminationGracePeriodSeconds: proto.TerminationGracePeriodSeconds,
+		SecurityContext:               podSecurityContextToDomain(proto.SecurityContext),
 	}
 	for i, c := range proto.Containers {
 		if c != nil {
 			app.Containers[i] = *containerToDomain(c)
 		}
 	}
+	for _, c := range proto.InitContainers {
+		if c != nil {
+			app.InitContainers = append(app.InitContainers, *containerToDomain(c))
+		}
+	}
 	return app
 }
 
@@ -38,8 +76,13 @@ func containerToProto(c *domain.Container) *scalehandlerv1.Container {
 		return nil
 	}
 	proto := &scalehandlerv1.Container{
-		Name:  c.Name,
-		Image: c.Image,
+		Name:            c.Name,
+		Image:           c.Image,
+		Command:         c.Command,
+		Args:            c.Args,
+		ImagePullPolicy: c.ImagePullPolicy,
+		SecurityContext: securityContextToProto(c.SecurityContext),
+		Lifecycle:       lifecycleToProto(c.Lifecycle),
 	}
 	if len(c.Ports) > 0 {
 		proto.Ports = make([]*scalehandlerv1.ContainerPort, len(c.Ports))
@@ -50,12 +93,9 @@ func containerToProto(c *domain.Container) *scalehandlerv1.Container {
 			}
 		}
 	}
-	if len(c.Env) > 0 {
-		proto.Env = make([]*scalehandlerv1.EnvVar, len(c.Env))
-		for i, e := range c.Env {
-			proto.Env[i] = &scalehandlerv1.EnvVar{Name: e.Name, Value: e.Value}
-		}
-	}
+	proto.Env = envToProto(c.Env)
+	proto.EnvFrom = envFromToProto(c.EnvFrom)
+	proto.VolumeMounts = volumeMountsToProto(c.VolumeMounts)
 	if c.Resources != nil {
 		proto.Resources = &scalehandlerv1.Resources{}
 		if c.Resources.Requests != nil {
@@ -74,20 +114,9 @@ func containerToProto(c *domain.Container) *scalehandlerv1.Container {
 			proto.Resources = nil
 		}
 	}
-	if c.LivenessProbe != nil && c.LivenessProbe.HTTPGet != nil {
-		proto.LivenessProbe = &scalehandlerv1.Probe{
-			HttpGet:             &scalehandlerv1.HttpGetAction{Path: c.LivenessProbe.HTTPGet.Path, Port: c.LivenessProbe.HTTPGet.Port},
-			InitialDelaySeconds: c.LivenessProbe.InitialDelaySeconds,
-			PeriodSeconds:       c.LivenessProbe.PeriodSeconds,
-		}
-	}
-	if c.ReadinessProbe != nil && c.ReadinessProbe.HTTPGet != nil {
-		proto.ReadinessProbe = &scalehandlerv1.Probe{
-			HttpGet:             &scalehandlerv1.HttpGetAction{Path: c.ReadinessProbe.HTTPGet.Path, Port: c.ReadinessProbe.HTTPGet.Port},
-			InitialDelaySeconds: c.ReadinessProbe.InitialDelaySeconds,
-			PeriodSeconds:       c.ReadinessProbe.PeriodSeconds,
-		}
-	}
+	proto.LivenessProbe = probeToProto(c.LivenessProbe)
+	proto.ReadinessProbe = probeToProto(c.ReadinessProbe)
+	proto.StartupProbe = probeToProto(c.StartupProbe)
 	return proto
 }
 
@@ -96,8 +125,13 @@ func containerToDomain(proto *scalehandlerv1.Container) *domain.Container {
 		return nil
 	}
 	c := &domain.Container{
-		Name:  proto.Name,
-		Image: proto.Image,
+		Name:            proto.Name,
+		Image:           proto.Image,
+		Command:         proto.Command,
+		Args:            proto.Args,
+		ImagePullPolicy: proto.ImagePullPolicy,
+		SecurityContext: securityContextToDomain(proto.SecurityContext),
+		Lifecycle:       lifecycleToDomain(proto.Lifecycle),
 	}
 	if len(proto.Ports) > 0 {
 		c.Ports = make([]domain.ContainerPort, len(proto.Ports))
@@ -110,14 +144,9 @@ func containerToDomain(proto *scalehandlerv1.Container) *domain.Container {
 			}
 		}
 	}
-	if len(proto.Env) > 0 {
-		c.Env = make([]domain.EnvVar, len(proto.Env))
-		for i, e := range proto.Env {
-			if e != nil {
-				c.Env[i] = domain.EnvVar{Name: e.Name, Value: e.Value}
-			}
-		}
-	}
+	c.Env = envToDomain(proto.Env)
+	c.EnvFrom = envFromToDomain(proto.EnvFrom)
+	c.VolumeMounts = volumeMountsToDomain(proto.VolumeMounts)
 	if proto.Resources != nil {
 		c.Resources = &domain.Resources{}
 		if proto.Resources.Requests != nil {
@@ -133,19 +162,8 @@ func containerToDomain(proto *scalehandlerv1.Container) *domain.Container {
 			}
 		}
 	}
-	if proto.LivenessProbe != nil && proto.LivenessProbe.HttpGet != nil {
-		c.LivenessProbe = &domain.Probe{
-			HTTPGet:             &domain.HTTPGetAction{Path: proto.LivenessProbe.HttpGet.Path, Port: proto.LivenessProbe.HttpGet.Port},
-			InitialDelaySeconds: proto.LivenessProbe.InitialDelaySeconds,
-			PeriodSeconds:       proto.LivenessProbe.PeriodSeconds,
-		}
-	}
-	if proto.ReadinessProbe != nil && proto.ReadinessProbe.HttpGet != nil {
-		c.ReadinessProbe = &domain.Probe{
-			HTTPGet:             &domain.HTTPGetAction{Path: proto.ReadinessProbe.HttpGet.Path, Port: proto.ReadinessProbe.HttpGet.Port},
-			InitialDelaySeconds: proto.ReadinessProbe.InitialDelaySeconds,
-			PeriodSeconds:       proto.ReadinessProbe.PeriodSeconds,
-		}
-	}
+	c.LivenessProbe = probeToDomain(proto.LivenessProbe)
+	c.ReadinessProbe = probeToDomain(proto.ReadinessProbe)
+	c.StartupProbe = probeToDomain(proto.StartupProbe)
 	return c
 }
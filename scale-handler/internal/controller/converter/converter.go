@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"time"
+
 	"scale-handler/internal/domain"
 	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
 )
@@ -11,9 +13,17 @@ func DomainToProto(schedule *domain.Schedule) *scalehandlerv1.Schedule {
 	}
 
 	protoSchedule := &scalehandlerv1.Schedule{
-		Weekdays:   make(map[string]*scalehandlerv1.Schedule_DaySchedule),
-		Dates:      make(map[string]*scalehandlerv1.Schedule_DaySchedule),
-		Exceptions: schedule.Rules.Exceptions,
+		Weekdays:        make(map[string]*scalehandlerv1.Schedule_DaySchedule),
+		Dates:           make(map[string]*scalehandlerv1.Schedule_DaySchedule),
+		Exceptions:      schedule.Rules.Exceptions,
+		Timezone:        schedule.Rules.Timezone,
+		Namespace:       schedule.Namespace,
+		Scaling:         ScalingToProto(schedule.Rules.Scaling),
+		DefaultReplicas: schedule.Rules.DefaultReplicas,
+		Triggers:        TriggersToProto(schedule.Rules.Triggers),
+		Target:          TargetToProto(schedule.Target),
+		Labels:          schedule.Labels,
+		Annotations:     schedule.Annotations,
 	}
 
 	// Конвертируем weekdays
@@ -45,15 +55,30 @@ func DomainToProto(schedule *domain.Schedule) *scalehandlerv1.Schedule {
 	return protoSchedule
 }
 
+func ProtoToDomain(protoSchedule *scalehandlerv1.Schedule, protoApplication *scalehandlerv1.Application) *domain.Schedule {
+	return &domain.Schedule{
+		Namespace:   protoSchedule.GetNamespace(),
+		Rules:       ProtoToDomainRules(protoSchedule),
+		Application: ProtoToApplication(protoApplication),
+		Target:      ProtoToTarget(protoSchedule.GetTarget()),
+		Labels:      protoSchedule.GetLabels(),
+		Annotations: protoSchedule.GetAnnotations(),
+	}
+}
+
 func ProtoToDomainRules(protoSchedule *scalehandlerv1.Schedule) domain.ScheduleRules {
 	if protoSchedule == nil {
 		return domain.ScheduleRules{}
 	}
 
 	rules := domain.ScheduleRules{
-		Weekdays:   make(map[string][]domain.TimeRange),
-		Dates:      make(map[string][]domain.TimeRange),
-		Exceptions: protoSchedule.Exceptions,
+		Weekdays:        make(map[string][]domain.TimeRange),
+		Dates:           make(map[string][]domain.TimeRange),
+		Exceptions:      protoSchedule.Exceptions,
+		Timezone:        protoSchedule.Timezone,
+		Scaling:         ProtoToScaling(protoSchedule.Scaling),
+		DefaultReplicas: protoSchedule.DefaultReplicas,
+		Triggers:        ProtoToTriggers(protoSchedule.Triggers),
 	}
 
 	// Конвертируем weekdays
@@ -88,3 +113,50 @@ func ProtoToDomainRules(protoSchedule *scalehandlerv1.Schedule) domain.ScheduleR
 
 	return rules
 }
+
+func StatusToProto(schedule *domain.Schedule) *scalehandlerv1.ScheduleStatus {
+	status := &scalehandlerv1.ScheduleStatus{
+		Phase:             schedule.Status.Phase,
+		LastError:         schedule.Status.LastError,
+		AppliedGeneration: schedule.Status.AppliedGeneration,
+		Generation:        schedule.Generation,
+		DefaultReplicas:   schedule.Rules.DefaultReplicas,
+	}
+	status.MinReplicaCount, status.MaxReplicaCount = schedule.Rules.ReplicaBounds()
+	if schedule.Status.LastAppliedAt != nil {
+		status.LastAppliedAt = schedule.Status.LastAppliedAt.Format(time.RFC3339)
+	}
+	if p := schedule.Pause; p != nil {
+		status.Pause = &scalehandlerv1.PauseState{
+			Replicas: p.Replicas,
+			PausedBy: p.PausedBy,
+			Reason:   p.Reason,
+			PausedAt: p.PausedAt.Format(time.RFC3339),
+		}
+	}
+	return status
+}
+
+func TargetToProto(target *domain.TargetRef) *scalehandlerv1.TargetRef {
+	if target == nil {
+		return nil
+	}
+	return &scalehandlerv1.TargetRef{
+		ApiVersion: target.APIVersion,
+		Kind:       target.Kind,
+		Name:       target.Name,
+		Namespace:  target.Namespace,
+	}
+}
+
+func ProtoToTarget(target *scalehandlerv1.TargetRef) *domain.TargetRef {
+	if target == nil {
+		return nil
+	}
+	return &domain.TargetRef{
+		APIVersion: target.ApiVersion,
+		Kind:       target.Kind,
+		Name:       target.Name,
+		Namespace:  target.Namespace,
+	}
+}
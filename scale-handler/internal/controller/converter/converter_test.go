@@ -0,0 +1,46 @@
+package converter
+
+import (
+	"testing"
+	"time"
+
+	"scale-handler/internal/domain"
+)
+
+func TestStatusToProto(t *testing.T) {
+	minReplicas := int32(1)
+	schedule := &domain.Schedule{
+		Generation: 4,
+		Rules: domain.ScheduleRules{
+			DefaultReplicas: 2,
+			Scaling:         &domain.ScalingOptions{MinReplicaCount: &minReplicas},
+		},
+		Status: domain.ScheduleStatus{Phase: domain.PhaseApplied, AppliedGeneration: 3},
+	}
+
+	status := StatusToProto(schedule)
+	if status.Phase != domain.PhaseApplied || status.Generation != 4 || status.AppliedGeneration != 3 {
+		t.Errorf("status = %+v", status)
+	}
+	if status.LastAppliedAt != "" {
+		t.Errorf("lastAppliedAt = %q, want empty", status.LastAppliedAt)
+	}
+	// minReplicaCount поднимается до defaultReplicas
+	if status.DefaultReplicas != 2 || status.MinReplicaCount != 2 || status.MaxReplicaCount != domain.DefaultMaxReplicaCount {
+		t.Errorf("replicas = %d [%d, %d], want 2 [2, %d]", status.DefaultReplicas, status.MinReplicaCount, status.MaxReplicaCount, domain.DefaultMaxReplicaCount)
+	}
+	if status.Pause != nil {
+		t.Errorf("pause = %+v, want nil", status.Pause)
+	}
+
+	schedule.Pause = &domain.PauseState{
+		Replicas: 3,
+		PausedBy: "oncall",
+		Reason:   "incident",
+		PausedAt: time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC),
+	}
+	pause := StatusToProto(schedule).Pause
+	if pause == nil || pause.Replicas != 3 || pause.PausedBy != "oncall" || pause.PausedAt != "2025-03-10T12:00:00Z" {
+		t.Errorf("pause = %+v", pause)
+	}
+}
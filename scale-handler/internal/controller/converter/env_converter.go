@@ -0,0 +1,104 @@
+package converter
+
+import (
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func envToProto(env []domain.EnvVar) []*scalehandlerv1.EnvVar {
+	if len(env) == 0 {
+		return nil
+	}
+	result := make([]*scalehandlerv1.EnvVar, len(env))
+	for i, e := range env {
+		result[i] = &scalehandlerv1.EnvVar{Name: e.Name, Value: e.Value}
+		if src := e.ValueFrom; src != nil {
+			result[i].ValueFrom = &scalehandlerv1.EnvVarSource{
+				SecretKeyRef:    keySelectorToProto(src.SecretKeyRef),
+				ConfigMapKeyRef: keySelectorToProto(src.ConfigMapKeyRef),
+			}
+			if src.FieldRef != nil {
+				result[i].ValueFrom.FieldRef = &scalehandlerv1.FieldRef{FieldPath: src.FieldRef.FieldPath}
+			}
+		}
+	}
+	return result
+}
+
+func envToDomain(env []*scalehandlerv1.EnvVar) []domain.EnvVar {
+	if len(env) == 0 {
+		return nil
+	}
+	result := make([]domain.EnvVar, 0, len(env))
+	for _, e := range env {
+		if e == nil {
+			continue
+		}
+		v := domain.EnvVar{Name: e.Name, Value: e.Value}
+		if src := e.ValueFrom; src != nil {
+			v.ValueFrom = &domain.EnvVarSource{
+				SecretKeyRef:    keySelectorToDomain(src.SecretKeyRef),
+				ConfigMapKeyRef: keySelectorToDomain(src.ConfigMapKeyRef),
+			}
+			if src.FieldRef != nil {
+				v.ValueFrom.FieldRef = &domain.FieldRef{FieldPath: src.FieldRef.FieldPath}
+			}
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+func envFromToProto(envFrom []domain.EnvFromSource) []*scalehandlerv1.EnvFromSource {
+	var result []*scalehandlerv1.EnvFromSource
+	for _, e := range envFrom {
+		result = append(result, &scalehandlerv1.EnvFromSource{
+			Prefix:       e.Prefix,
+			SecretRef:    objectRefToProto(e.SecretRef),
+			ConfigMapRef: objectRefToProto(e.ConfigMapRef),
+		})
+	}
+	return result
+}
+
+func envFromToDomain(envFrom []*scalehandlerv1.EnvFromSource) []domain.EnvFromSource {
+	var result []domain.EnvFromSource
+	for _, e := range envFrom {
+		if e != nil {
+			result = append(result, domain.EnvFromSource{
+				Prefix:       e.Prefix,
+				SecretRef:    objectRefToDomain(e.SecretRef),
+				ConfigMapRef: objectRefToDomain(e.ConfigMapRef),
+			})
+		}
+	}
+	return result
+}
+
+func keySelectorToProto(s *domain.KeySelector) *scalehandlerv1.KeySelector {
+	if s == nil {
+		return nil
+	}
+	return &scalehandlerv1.KeySelector{Name: s.Name, Key: s.Key, Optional: s.Optional}
+}
+
+func keySelectorToDomain(s *scalehandlerv1.KeySelector) *domain.KeySelector {
+	if s == nil {
+		return nil
+	}
+	return &domain.KeySelector{Name: s.Name, Key: s.Key, Optional: s.Optional}
+}
+
+func objectRefToProto(ref *domain.LocalObjectRef) *scalehandlerv1.LocalObjectRef {
+	if ref == nil {
+		return nil
+	}
+	return &scalehandlerv1.LocalObjectRef{Name: ref.Name, Optional: ref.Optional}
+}
+
+func objectRefToDomain(ref *scalehandlerv1.LocalObjectRef) *domain.LocalObjectRef {
+	if ref == nil {
+		return nil
+	}
+	return &domain.LocalObjectRef{Name: ref.Name, Optional: ref.Optional}
+}
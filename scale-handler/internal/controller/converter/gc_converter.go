@@ -0,0 +1,33 @@
+package converter
+
+import (
+	"sort"
+	"time"
+
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func GCReportToProto(report *domain.GCReport) *scalehandlerv1.CollectGarbageResponse {
+	resp := &scalehandlerv1.CollectGarbageResponse{
+		Checked: int32(report.Checked),
+	}
+	for _, o := range report.Orphans {
+		orphan := &scalehandlerv1.OrphanedResource{
+			ScheduleId: o.ScheduleID,
+			Kind:       o.Kind,
+			Namespace:  o.Namespace,
+			Name:       o.Name,
+			Action:     o.Action,
+		}
+		if !o.OrphanedAt.IsZero() {
+			orphan.OrphanedAt = o.OrphanedAt.Format(time.RFC3339)
+		}
+		resp.Orphans = append(resp.Orphans, orphan)
+	}
+	for key, err := range report.Failed {
+		resp.Errors = append(resp.Errors, key+": "+err.Error())
+	}
+	sort.Strings(resp.Errors)
+	return resp
+}
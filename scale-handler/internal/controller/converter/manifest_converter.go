@@ -0,0 +1,41 @@
+package converter
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+// Форматы manifests
+const (
+	FormatJSON = "json"
+	FormatYAML = "yaml"
+)
+
+func ManifestsToProto(objects []domain.Manifest, format string) ([]*scalehandlerv1.Manifest, error) {
+	if format != "" && format != FormatJSON && format != FormatYAML {
+		return nil, fmt.Errorf("unsupported manifest format %s: %w", format, domain.ErrInvalidArgument)
+	}
+
+	manifests := make([]*scalehandlerv1.Manifest, len(objects))
+	for i, obj := range objects {
+		content := obj.Content
+		if format == FormatYAML {
+			var err error
+			if content, err = yaml.JSONToYAML(obj.Content); err != nil {
+				return nil, fmt.Errorf("convert %s %s to yaml: %w", obj.Kind, obj.Name, err)
+			}
+		}
+		manifests[i] = &scalehandlerv1.Manifest{
+			ApiVersion: obj.APIVersion,
+			Kind:       obj.Kind,
+			Name:       obj.Name,
+			Namespace:  obj.Namespace,
+			Content:    string(content),
+		}
+	}
+	return manifests, nil
+}
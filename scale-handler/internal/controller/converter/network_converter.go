@@ -0,0 +1,68 @@
+package converter
+
+import (
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func serviceToProto(s *domain.ServiceSpec) *scalehandlerv1.ServiceSpec {
+	if s == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.ServiceSpec{Type: s.Type}
+	for _, p := range s.Ports {
+		proto.Ports = append(proto.Ports, &scalehandlerv1.ServicePort{
+			Name:       p.Name,
+			Port:       p.Port,
+			TargetPort: p.TargetPort,
+			Protocol:   p.Protocol,
+		})
+	}
+	return proto
+}
+
+func serviceToDomain(proto *scalehandlerv1.ServiceSpec) *domain.ServiceSpec {
+	if proto == nil {
+		return nil
+	}
+	s := &domain.ServiceSpec{Type: proto.Type}
+	for _, p := range proto.Ports {
+		if p != nil {
+			s.Ports = append(s.Ports, domain.ServicePort{
+				Name:       p.Name,
+				Port:       p.Port,
+				TargetPort: p.TargetPort,
+				Protocol:   p.Protocol,
+			})
+		}
+	}
+	return s
+}
+
+func ingressToProto(i *domain.IngressSpec) *scalehandlerv1.IngressSpec {
+	if i == nil {
+		return nil
+	}
+	return &scalehandlerv1.IngressSpec{
+		ClassName:     i.ClassName,
+		Host:          i.Host,
+		Path:          i.Path,
+		PathType:      i.PathType,
+		ServicePort:   i.ServicePort,
+		TlsSecretName: i.TLSSecretName,
+	}
+}
+
+func ingressToDomain(proto *scalehandlerv1.IngressSpec) *domain.IngressSpec {
+	if proto == nil {
+		return nil
+	}
+	return &domain.IngressSpec{
+		ClassName:     proto.ClassName,
+		Host:          proto.Host,
+		Path:          proto.Path,
+		PathType:      proto.PathType,
+		ServicePort:   proto.ServicePort,
+		TLSSecretName: proto.TlsSecretName,
+	}
+}
@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"time"
+
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func OverrideToProto(o domain.Override, now time.Time) *scalehandlerv1.Override {
+	return &scalehandlerv1.Override{
+		Id:        o.ID,
+		Replicas:  o.Replicas,
+		Start:     o.Start.Format(time.RFC3339),
+		End:       o.End.Format(time.RFC3339),
+		Reason:    o.Reason,
+		CreatedAt: o.CreatedAt.Format(time.RFC3339),
+		Active:    o.Active(now),
+	}
+}
+
+// ProtoToOverride разбирает запрос; пустое начало остаётся нулевым и
+// заменяется текущим моментом при создании
+func ProtoToOverride(req *scalehandlerv1.CreateOverrideRequest) (domain.Override, error) {
+	o := domain.Override{
+		ScheduleID: req.ScheduleId,
+		Replicas:   req.Replicas,
+		Reason:     req.Reason,
+	}
+	var err error
+	if req.Start != "" {
+		if o.Start, err = time.Parse(time.RFC3339, req.Start); err != nil {
+			return o, err
+		}
+	}
+	o.End, err = time.Parse(time.RFC3339, req.End)
+	return o, err
+}
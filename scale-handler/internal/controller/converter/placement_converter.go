@@ -0,0 +1,218 @@
+package converter
+
+import (
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func tolerationsToProto(tolerations []domain.Toleration) []*scalehandlerv1.Toleration {
+	var result []*scalehandlerv1.Toleration
+	for _, t := range tolerations {
+		result = append(result, &scalehandlerv1.Toleration{
+			Key:               t.Key,
+			Operator:          t.Operator,
+			Value:             t.Value,
+			Effect:            t.Effect,
+			TolerationSeconds: t.TolerationSeconds,
+		})
+	}
+	return result
+}
+
+func tolerationsToDomain(tolerations []*scalehandlerv1.Toleration) []domain.Toleration {
+	var result []domain.Toleration
+	for _, t := range tolerations {
+		if t != nil {
+			result = append(result, domain.Toleration{
+				Key:               t.Key,
+				Operator:          t.Operator,
+				Value:             t.Value,
+				Effect:            t.Effect,
+				TolerationSeconds: t.TolerationSeconds,
+			})
+		}
+	}
+	return result
+}
+
+func affinityToProto(a *domain.Affinity) *scalehandlerv1.Affinity {
+	if a == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.Affinity{
+		PodAffinity:     podAffinityToProto(a.PodAffinity),
+		PodAntiAffinity: podAffinityToProto(a.PodAntiAffinity),
+	}
+	if na := a.NodeAffinity; na != nil {
+		proto.NodeAffinity = &scalehandlerv1.NodeAffinity{}
+		for _, term := range na.Required {
+			proto.NodeAffinity.Required = append(proto.NodeAffinity.Required, nodeSelectorTermToProto(term))
+		}
+		for _, p := range na.Preferred {
+			proto.NodeAffinity.Preferred = append(proto.NodeAffinity.Preferred, &scalehandlerv1.PreferredSchedulingTerm{
+				Weight:     p.Weight,
+				Preference: nodeSelectorTermToProto(p.Preference),
+			})
+		}
+	}
+	return proto
+}
+
+func affinityToDomain(proto *scalehandlerv1.Affinity) *domain.Affinity {
+	if proto == nil {
+		return nil
+	}
+	a := &domain.Affinity{
+		PodAffinity:     podAffinityToDomain(proto.PodAffinity),
+		PodAntiAffinity: podAffinityToDomain(proto.PodAntiAffinity),
+	}
+	if na := proto.NodeAffinity; na != nil {
+		a.NodeAffinity = &domain.NodeAffinity{}
+		for _, term := range na.Required {
+			a.NodeAffinity.Required = append(a.NodeAffinity.Required, nodeSelectorTermToDomain(term))
+		}
+		for _, p := range na.Preferred {
+			if p != nil {
+				a.NodeAffinity.Preferred = append(a.NodeAffinity.Preferred, domain.PreferredSchedulingTerm{
+					Weight:     p.Weight,
+					Preference: nodeSelectorTermToDomain(p.Preference),
+				})
+			}
+		}
+	}
+	return a
+}
+
+func nodeSelectorTermToProto(term domain.NodeSelectorTerm) *scalehandlerv1.NodeSelectorTerm {
+	return &scalehandlerv1.NodeSelectorTerm{MatchExpressions: requirementsToProto(term.MatchExpressions)}
+}
+
+func nodeSelectorTermToDomain(term *scalehandlerv1.NodeSelectorTerm) domain.NodeSelectorTerm {
+	if term == nil {
+		return domain.NodeSelectorTerm{}
+	}
+	return domain.NodeSelectorTerm{MatchExpressions: requirementsToDomain(term.MatchExpressions)}
+}
+
+func podAffinityToProto(a *domain.PodAffinity) *scalehandlerv1.PodAffinity {
+	if a == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.PodAffinity{}
+	for _, term := range a.Required {
+		proto.Required = append(proto.Required, podAffinityTermToProto(term))
+	}
+	for _, p := range a.Preferred {
+		proto.Preferred = append(proto.Preferred, &scalehandlerv1.WeightedPodAffinityTerm{
+			Weight: p.Weight,
+			Term:   podAffinityTermToProto(p.Term),
+		})
+	}
+	return proto
+}
+
+func podAffinityToDomain(proto *scalehandlerv1.PodAffinity) *domain.PodAffinity {
+	if proto == nil {
+		return nil
+	}
+	a := &domain.PodAffinity{}
+	for _, term := range proto.Required {
+		a.Required = append(a.Required, podAffinityTermToDomain(term))
+	}
+	for _, p := range proto.Preferred {
+		if p != nil {
+			a.Preferred = append(a.Preferred, domain.WeightedPodAffinityTerm{
+				Weight: p.Weight,
+				Term:   podAffinityTermToDomain(p.Term),
+			})
+		}
+	}
+	return a
+}
+
+func podAffinityTermToProto(term domain.PodAffinityTerm) *scalehandlerv1.PodAffinityTerm {
+	return &scalehandlerv1.PodAffinityTerm{
+		LabelSelector: labelSelectorToProto(term.LabelSelector),
+		TopologyKey:   term.TopologyKey,
+		Namespaces:    term.Namespaces,
+	}
+}
+
+func podAffinityTermToDomain(term *scalehandlerv1.PodAffinityTerm) domain.PodAffinityTerm {
+	if term == nil {
+		return domain.PodAffinityTerm{}
+	}
+	return domain.PodAffinityTerm{
+		LabelSelector: labelSelectorToDomain(term.LabelSelector),
+		TopologyKey:   term.TopologyKey,
+		Namespaces:    term.Namespaces,
+	}
+}
+
+func topologySpreadToProto(constraints []domain.TopologySpreadConstraint) []*scalehandlerv1.TopologySpreadConstraint {
+	var result []*scalehandlerv1.TopologySpreadConstraint
+	for _, c := range constraints {
+		result = append(result, &scalehandlerv1.TopologySpreadConstraint{
+			MaxSkew:           c.MaxSkew,
+			TopologyKey:       c.TopologyKey,
+			WhenUnsatisfiable: c.WhenUnsatisfiable,
+			LabelSelector:     labelSelectorToProto(c.LabelSelector),
+			MinDomains:        c.MinDomains,
+		})
+	}
+	return result
+}
+
+func topologySpreadToDomain(constraints []*scalehandlerv1.TopologySpreadConstraint) []domain.TopologySpreadConstraint {
+	var result []domain.TopologySpreadConstraint
+	for _, c := range constraints {
+		if c != nil {
+			result = append(result, domain.TopologySpreadConstraint{
+				MaxSkew:           c.MaxSkew,
+				TopologyKey:       c.TopologyKey,
+				WhenUnsatisfiable: c.WhenUnsatisfiable,
+				LabelSelector:     labelSelectorToDomain(c.LabelSelector),
+				MinDomains:        c.MinDomains,
+			})
+		}
+	}
+	return result
+}
+
+func labelSelectorToProto(s *domain.LabelSelector) *scalehandlerv1.LabelSelector {
+	if s == nil {
+		return nil
+	}
+	return &scalehandlerv1.LabelSelector{
+		MatchLabels:      s.MatchLabels,
+		MatchExpressions: requirementsToProto(s.MatchExpressions),
+	}
+}
+
+func labelSelectorToDomain(s *scalehandlerv1.LabelSelector) *domain.LabelSelector {
+	if s == nil {
+		return nil
+	}
+	return &domain.LabelSelector{
+		MatchLabels:      s.MatchLabels,
+		MatchExpressions: requirementsToDomain(s.MatchExpressions),
+	}
+}
+
+func requirementsToProto(reqs []domain.SelectorRequirement) []*scalehandlerv1.SelectorRequirement {
+	var result []*scalehandlerv1.SelectorRequirement
+	for _, r := range reqs {
+		result = append(result, &scalehandlerv1.SelectorRequirement{Key: r.Key, Operator: r.Operator, Values: r.Values})
+	}
+	return result
+}
+
+func requirementsToDomain(reqs []*scalehandlerv1.SelectorRequirement) []domain.SelectorRequirement {
+	var result []domain.SelectorRequirement
+	for _, r := range reqs {
+		if r != nil {
+			result = append(result, domain.SelectorRequirement{Key: r.Key, Operator: r.Operator, Values: r.Values})
+		}
+	}
+	return result
+}
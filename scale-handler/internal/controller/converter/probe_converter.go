@@ -0,0 +1,88 @@
+package converter
+
+import (
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func probeToProto(p *domain.Probe) *scalehandlerv1.Probe {
+	if p == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.Probe{
+		InitialDelaySeconds: p.InitialDelaySeconds,
+		PeriodSeconds:       p.PeriodSeconds,
+		TimeoutSeconds:      p.TimeoutSeconds,
+		FailureThreshold:    p.FailureThreshold,
+		SuccessThreshold:    p.SuccessThreshold,
+		HttpGet:             httpGetToProto(p.HTTPGet),
+		Exec:                execToProto(p.Exec),
+	}
+	if p.TCPSocket != nil {
+		proto.TcpSocket = &scalehandlerv1.TcpSocketAction{Port: p.TCPSocket.Port}
+	}
+	if p.GRPC != nil {
+		proto.Grpc = &scalehandlerv1.GrpcAction{Port: p.GRPC.Port, Service: p.GRPC.Service}
+	}
+	return proto
+}
+
+func probeToDomain(proto *scalehandlerv1.Probe) *domain.Probe {
+	if proto == nil {
+		return nil
+	}
+	p := &domain.Probe{
+		InitialDelaySeconds: proto.InitialDelaySeconds,
+		PeriodSeconds:       proto.PeriodSeconds,
+		TimeoutSeconds:      proto.TimeoutSeconds,
+		FailureThreshold:    proto.FailureThreshold,
+		SuccessThreshold:    proto.SuccessThreshold,
+		HTTPGet:             httpGetToDomain(proto.HttpGet),
+		Exec:                execToDomain(proto.Exec),
+	}
+	if proto.TcpSocket != nil {
+		p.TCPSocket = &domain.TCPSocketAction{Port: proto.TcpSocket.Port}
+	}
+	if proto.Grpc != nil {
+		p.GRPC = &domain.GRPCAction{Port: proto.Grpc.Port, Service: proto.Grpc.Service}
+	}
+	return p
+}
+
+func httpGetToProto(h *domain.HTTPGetAction) *scalehandlerv1.HttpGetAction {
+	if h == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.HttpGetAction{Path: h.Path, Port: h.Port, Scheme: h.Scheme}
+	for _, header := range h.HTTPHeaders {
+		proto.HttpHeaders = append(proto.HttpHeaders, &scalehandlerv1.HttpHeader{Name: header.Name, Value: header.Value})
+	}
+	return proto
+}
+
+func httpGetToDomain(proto *scalehandlerv1.HttpGetAction) *domain.HTTPGetAction {
+	if proto == nil {
+		return nil
+	}
+	h := &domain.HTTPGetAction{Path: proto.Path, Port: proto.Port, Scheme: proto.Scheme}
+	for _, header := range proto.HttpHeaders {
+		if header != nil {
+			h.HTTPHeaders = append(h.HTTPHeaders, domain.HTTPHeader{Name: header.Name, Value: header.Value})
+		}
+	}
+	return h
+}
+
+func execToProto(e *domain.ExecAction) *scalehandlerv1.ExecAction {
+	if e == nil {
+		return nil
+	}
+	return &scalehandlerv1.ExecAction{Command: e.Command}
+}
+
+func execToDomain(proto *scalehandlerv1.ExecAction) *domain.ExecAction {
+	if proto == nil {
+		return nil
+	}
+	return &domain.ExecAction{Command: proto.Command}
+}
@@ -0,0 +1,100 @@
+package converter
+
+import (
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func securityContextToProto(sc *domain.SecurityContext) *scalehandlerv1.SecurityContext {
+	if sc == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.SecurityContext{
+		RunAsUser:                sc.RunAsUser,
+		RunAsGroup:               sc.RunAsGroup,
+		RunAsNonRoot:             sc.RunAsNonRoot,
+		ReadOnlyRootFilesystem:   sc.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: sc.AllowPrivilegeEscalation,
+		Privileged:               sc.Privileged,
+	}
+	if sc.Capabilities != nil {
+		proto.Capabilities = &scalehandlerv1.Capabilities{Add: sc.Capabilities.Add, Drop: sc.Capabilities.Drop}
+	}
+	return proto
+}
+
+func securityContextToDomain(proto *scalehandlerv1.SecurityContext) *domain.SecurityContext {
+	if proto == nil {
+		return nil
+	}
+	sc := &domain.SecurityContext{
+		RunAsUser:                proto.RunAsUser,
+		RunAsGroup:               proto.RunAsGroup,
+		RunAsNonRoot:             proto.RunAsNonRoot,
+		ReadOnlyRootFilesystem:   proto.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: proto.AllowPrivilegeEscalation,
+		Privileged:               proto.Privileged,
+	}
+	if proto.Capabilities != nil {
+		sc.Capabilities = &domain.Capabilities{Add: proto.Capabilities.Add, Drop: proto.Capabilities.Drop}
+	}
+	return sc
+}
+
+func podSecurityContextToProto(sc *domain.PodSecurityContext) *scalehandlerv1.PodSecurityContext {
+	if sc == nil {
+		return nil
+	}
+	return &scalehandlerv1.PodSecurityContext{
+		RunAsUser:    sc.RunAsUser,
+		RunAsGroup:   sc.RunAsGroup,
+		RunAsNonRoot: sc.RunAsNonRoot,
+		FsGroup:      sc.FSGroup,
+	}
+}
+
+func podSecurityContextToDomain(proto *scalehandlerv1.PodSecurityContext) *domain.PodSecurityContext {
+	if proto == nil {
+		return nil
+	}
+	return &domain.PodSecurityContext{
+		RunAsUser:    proto.RunAsUser,
+		RunAsGroup:   proto.RunAsGroup,
+		RunAsNonRoot: proto.RunAsNonRoot,
+		FSGroup:      proto.FsGroup,
+	}
+}
+
+func lifecycleToProto(l *domain.Lifecycle) *scalehandlerv1.Lifecycle {
+	if l == nil {
+		return nil
+	}
+	return &scalehandlerv1.Lifecycle{
+		PostStart: lifecycleHandlerToProto(l.PostStart),
+		PreStop:   lifecycleHandlerToProto(l.PreStop),
+	}
+}
+
+func lifecycleToDomain(proto *scalehandlerv1.Lifecycle) *domain.Lifecycle {
+	if proto == nil {
+		return nil
+	}
+	return &domain.Lifecycle{
+		PostStart: lifecycleHandlerToDomain(proto.PostStart),
+		PreStop:   lifecycleHandlerToDomain(proto.PreStop),
+	}
+}
+
+func lifecycleHandlerToProto(h *domain.LifecycleHandler) *scalehandlerv1.LifecycleHandler {
+	if h == nil {
+		return nil
+	}
+	return &scalehandlerv1.LifecycleHandler{Exec: execToProto(h.Exec), HttpGet: httpGetToProto(h.HTTPGet)}
+}
+
+func lifecycleHandlerToDomain(proto *scalehandlerv1.LifecycleHandler) *domain.LifecycleHandler {
+	if proto == nil {
+		return nil
+	}
+	return &domain.LifecycleHandler{Exec: execToDomain(proto.Exec), HTTPGet: httpGetToDomain(proto.HttpGet)}
+}
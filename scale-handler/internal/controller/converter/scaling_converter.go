@@ -0,0 +1,139 @@
+package converter
+
+import (
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func ScalingToProto(s *domain.ScalingOptions) *scalehandlerv1.ScalingOptions {
+	if s == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.ScalingOptions{
+		MinReplicaCount: s.MinReplicaCount,
+		MaxReplicaCount: s.MaxReplicaCount,
+		CooldownPeriod:  s.CooldownPeriod,
+		PollingInterval: s.PollingInterval,
+	}
+	if s.Fallback != nil {
+		proto.Fallback = &scalehandlerv1.Fallback{
+			FailureThreshold: s.Fallback.FailureThreshold,
+			Replicas:         s.Fallback.Replicas,
+		}
+	}
+	if s.Behavior != nil {
+		proto.Behavior = &scalehandlerv1.ScalingBehavior{
+			ScaleUp:   scalingRulesToProto(s.Behavior.ScaleUp),
+			ScaleDown: scalingRulesToProto(s.Behavior.ScaleDown),
+		}
+	}
+	return proto
+}
+
+func ProtoToScaling(proto *scalehandlerv1.ScalingOptions) *domain.ScalingOptions {
+	if proto == nil {
+		return nil
+	}
+	s := &domain.ScalingOptions{
+		MinReplicaCount: proto.MinReplicaCount,
+		MaxReplicaCount: proto.MaxReplicaCount,
+		CooldownPeriod:  proto.CooldownPeriod,
+		PollingInterval: proto.PollingInterval,
+	}
+	if proto.Fallback != nil {
+		s.Fallback = &domain.Fallback{
+			FailureThreshold: proto.Fallback.FailureThreshold,
+			Replicas:         proto.Fallback.Replicas,
+		}
+	}
+	if proto.Behavior != nil {
+		s.Behavior = &domain.ScalingBehavior{
+			ScaleUp:   scalingRulesToDomain(proto.Behavior.ScaleUp),
+			ScaleDown: scalingRulesToDomain(proto.Behavior.ScaleDown),
+		}
+	}
+	return s
+}
+
+func scalingRulesToProto(r *domain.ScalingRules) *scalehandlerv1.ScalingRules {
+	if r == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.ScalingRules{
+		StabilizationWindowSeconds: r.StabilizationWindowSeconds,
+		SelectPolicy:               r.SelectPolicy,
+	}
+	for _, p := range r.Policies {
+		proto.Policies = append(proto.Policies, &scalehandlerv1.ScalingPolicy{
+			Type:          p.Type,
+			Value:         p.Value,
+			PeriodSeconds: p.PeriodSeconds,
+		})
+	}
+	return proto
+}
+
+func scalingRulesToDomain(proto *scalehandlerv1.ScalingRules) *domain.ScalingRules {
+	if proto == nil {
+		return nil
+	}
+	r := &domain.ScalingRules{
+		StabilizationWindowSeconds: proto.StabilizationWindowSeconds,
+		SelectPolicy:               proto.SelectPolicy,
+	}
+	for _, p := range proto.Policies {
+		if p != nil {
+			r.Policies = append(r.Policies, domain.ScalingPolicy{
+				Type:          p.Type,
+				Value:         p.Value,
+				PeriodSeconds: p.PeriodSeconds,
+			})
+		}
+	}
+	return r
+}
+
+func TriggersToProto(triggers []domain.MetricTrigger) []*scalehandlerv1.MetricTrigger {
+	if len(triggers) == 0 {
+		return nil
+	}
+	result := make([]*scalehandlerv1.MetricTrigger, len(triggers))
+	for i, t := range triggers {
+		result[i] = &scalehandlerv1.MetricTrigger{
+			Type:       t.Type,
+			Name:       t.Name,
+			MetricType: t.MetricType,
+			Metadata:   t.Metadata,
+		}
+		if t.AuthenticationRef != nil {
+			result[i].AuthenticationRef = &scalehandlerv1.AuthenticationRef{
+				Name: t.AuthenticationRef.Name,
+				Kind: t.AuthenticationRef.Kind,
+			}
+		}
+	}
+	return result
+}
+
+func ProtoToTriggers(triggers []*scalehandlerv1.MetricTrigger) []domain.MetricTrigger {
+	var result []domain.MetricTrigger
+	for _, t := range triggers {
+		if t == nil {
+			continue
+		}
+		trigger := domain.MetricTrigger{
+			Type:       t.Type,
+			Name:       t.Name,
+			MetricType: t.MetricType,
+			Metadata:   t.Metadata,
+		}
+		if t.AuthenticationRef != nil {
+			trigger.AuthenticationRef = &domain.AuthenticationRef{
+				Name: t.AuthenticationRef.Name,
+				Kind: t.AuthenticationRef.Kind,
+			}
+		}
+		result = append(result, trigger)
+	}
+	return result
+}
@@ -0,0 +1,142 @@
+package converter
+
+import (
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func volumesToProto(volumes []domain.Volume) []*scalehandlerv1.Volume {
+	var result []*scalehandlerv1.Volume
+	for _, v := range volumes {
+		proto := &scalehandlerv1.Volume{
+			Name:      v.Name,
+			ConfigMap: objectVolumeToProto(v.ConfigMap),
+			Secret:    objectVolumeToProto(v.Secret),
+		}
+		if v.EmptyDir != nil {
+			proto.EmptyDir = &scalehandlerv1.EmptyDirVolume{Medium: v.EmptyDir.Medium, SizeLimit: v.EmptyDir.SizeLimit}
+		}
+		if v.PersistentVolumeClaim != nil {
+			proto.PersistentVolumeClaim = &scalehandlerv1.PersistentVolumeClaimVolume{
+				ClaimName: v.PersistentVolumeClaim.ClaimName,
+				ReadOnly:  v.PersistentVolumeClaim.ReadOnly,
+			}
+		}
+		if v.Projected != nil {
+			proto.Projected = &scalehandlerv1.ProjectedVolume{DefaultMode: v.Projected.DefaultMode}
+			for _, s := range v.Projected.Sources {
+				source := &scalehandlerv1.VolumeProjection{
+					ConfigMap: objectVolumeToProto(s.ConfigMap),
+					Secret:    objectVolumeToProto(s.Secret),
+				}
+				if t := s.ServiceAccountToken; t != nil {
+					source.ServiceAccountToken = &scalehandlerv1.ServiceAccountTokenProjection{
+						Audience:          t.Audience,
+						ExpirationSeconds: t.ExpirationSeconds,
+						Path:              t.Path,
+					}
+				}
+				proto.Projected.Sources = append(proto.Projected.Sources, source)
+			}
+		}
+		result = append(result, proto)
+	}
+	return result
+}
+
+func volumesToDomain(volumes []*scalehandlerv1.Volume) []domain.Volume {
+	var result []domain.Volume
+	for _, proto := range volumes {
+		if proto == nil {
+			continue
+		}
+		v := domain.Volume{
+			Name:      proto.Name,
+			ConfigMap: objectVolumeToDomain(proto.ConfigMap),
+			Secret:    objectVolumeToDomain(proto.Secret),
+		}
+		if proto.EmptyDir != nil {
+			v.EmptyDir = &domain.EmptyDirVolume{Medium: proto.EmptyDir.Medium, SizeLimit: proto.EmptyDir.SizeLimit}
+		}
+		if proto.PersistentVolumeClaim != nil {
+			v.PersistentVolumeClaim = &domain.PersistentVolumeClaim{
+				ClaimName: proto.PersistentVolumeClaim.ClaimName,
+				ReadOnly:  proto.PersistentVolumeClaim.ReadOnly,
+			}
+		}
+		if proto.Projected != nil {
+			v.Projected = &domain.ProjectedVolume{DefaultMode: proto.Projected.DefaultMode}
+			for _, s := range proto.Projected.Sources {
+				if s == nil {
+					continue
+				}
+				source := domain.VolumeProjection{
+					ConfigMap: objectVolumeToDomain(s.ConfigMap),
+					Secret:    objectVolumeToDomain(s.Secret),
+				}
+				if t := s.ServiceAccountToken; t != nil {
+					source.ServiceAccountToken = &domain.ServiceAccountTokenProjection{
+						Audience:          t.Audience,
+						ExpirationSeconds: t.ExpirationSeconds,
+						Path:              t.Path,
+					}
+				}
+				v.Projected.Sources = append(v.Projected.Sources, source)
+			}
+		}
+		result = append(result, v)
+	}
+	return result
+}
+
+func objectVolumeToProto(v *domain.ObjectVolume) *scalehandlerv1.ObjectVolume {
+	if v == nil {
+		return nil
+	}
+	proto := &scalehandlerv1.ObjectVolume{Name: v.Name, DefaultMode: v.DefaultMode, Optional: v.Optional}
+	for _, item := range v.Items {
+		proto.Items = append(proto.Items, &scalehandlerv1.KeyToPath{Key: item.Key, Path: item.Path, Mode: item.Mode})
+	}
+	return proto
+}
+
+func objectVolumeToDomain(proto *scalehandlerv1.ObjectVolume) *domain.ObjectVolume {
+	if proto == nil {
+		return nil
+	}
+	v := &domain.ObjectVolume{Name: proto.Name, DefaultMode: proto.DefaultMode, Optional: proto.Optional}
+	for _, item := range proto.Items {
+		if item != nil {
+			v.Items = append(v.Items, domain.KeyToPath{Key: item.Key, Path: item.Path, Mode: item.Mode})
+		}
+	}
+	return v
+}
+
+func volumeMountsToProto(mounts []domain.VolumeMount) []*scalehandlerv1.VolumeMount {
+	var result []*scalehandlerv1.VolumeMount
+	for _, m := range mounts {
+		result = append(result, &scalehandlerv1.VolumeMount{
+			Name:      m.Name,
+			MountPath: m.MountPath,
+			SubPath:   m.SubPath,
+			ReadOnly:  m.ReadOnly,
+		})
+	}
+	return result
+}
+
+func volumeMountsToDomain(mounts []*scalehandlerv1.VolumeMount) []domain.VolumeMount {
+	var result []domain.VolumeMount
+	for _, m := range mounts {
+		if m != nil {
+			result = append(result, domain.VolumeMount{
+				Name:      m.Name,
+				MountPath: m.MountPath,
+				SubPath:   m.SubPath,
+				ReadOnly:  m.ReadOnly,
+			})
+		}
+	}
+	return result
+}
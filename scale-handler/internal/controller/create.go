@@ -5,25 +5,48 @@ import (
 
 	"scale-handler/internal/controller/converter"
 	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+
+	"github.com/google/uuid"
 )
 
 func (c *Controller) Create(ctx context.Context, req *scalehandlerv1.CreateRequest) (*scalehandlerv1.CreateResponse, error) {
 	c.logger.Info("Handling Create request")
 
-	rules := converter.ProtoToDomainRules(req.Schedule)
-	application := converter.ProtoToApplication(req.Application)
+	schedule := converter.ProtoToDomain(req.Schedule, req.Application)
+	if req.DryRun {
+		// Настоящий ID выдаёт БД, для dry-run объекты называются временным
+		schedule.ID, schedule.Generation = uuid.NewString(), 1
+		manifests, err := c.dryRun(ctx, schedule, nil, req.Format)
+		if err != nil {
+			return nil, err
+		}
+		return &scalehandlerv1.CreateResponse{
+			Manifests: manifests,
+		}, nil
+	}
+
+	// Ссылки проверяются только после namespace по умолчанию и allow-list,
+	// иначе через них можно узнать об объектах в чужих namespace
+	if err := c.scheduleUC.ValidateSchedule(schedule, nil); err != nil {
+		c.logger.Error("Invalid schedule", "error", err)
+		return nil, toStatusError(err)
+	}
+	if err := c.reconciler.ValidateReferences(ctx, schedule); err != nil {
+		c.logger.Error("Invalid object references", "error", err)
+		return nil, toStatusError(err)
+	}
 
-	schedule, err := c.scheduleUC.CreateSchedule(ctx, rules, application)
+	schedule, err := c.scheduleUC.CreateSchedule(ctx, schedule)
 	if err != nil {
 		c.logger.Error("Failed to create schedule", "error", err)
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
-	if c.k8sReconciler != nil {
-		if err := c.k8sReconciler.CreateResources(ctx, schedule); err != nil {
-			c.logger.Error("Failed to create K8s resources", "id", schedule.ID, "error", err)
-		}
+	err = c.reconciler.CreateResources(ctx, schedule)
+	if err != nil {
+		c.logger.Error("Failed to create K8s resources", "id", schedule.ID, "error", err)
 	}
+	c.recordApplyResult(ctx, schedule, err)
 
 	return &scalehandlerv1.CreateResponse{
 		Id: schedule.ID,
@@ -9,16 +9,20 @@ import (
 func (c *Controller) Delete(ctx context.Context, req *scalehandlerv1.DeleteRequest) (*scalehandlerv1.DeleteResponse, error) {
 	c.logger.Info("Handling Delete request", "id", req.Id)
 
-	if c.k8sReconciler != nil {
-		if err := c.k8sReconciler.DeleteResources(ctx, req.Id); err != nil {
-			c.logger.Error("Failed to delete K8s resources", "id", req.Id, "error", err)
-		}
+	schedule, err := c.scheduleUC.GetSchedule(ctx, req.Id)
+	if err != nil {
+		c.logger.Error("Failed to get schedule", "id", req.Id, "error", err)
+		return nil, toStatusError(err)
+	}
+
+	if err := c.reconciler.DeleteResources(ctx, schedule); err != nil {
+		c.logger.Error("Failed to delete K8s resources", "id", req.Id, "error", err)
 	}
 
-	err := c.scheduleUC.DeleteSchedule(ctx, req.Id)
+	err = c.scheduleUC.DeleteSchedule(ctx, req.Id)
 	if err != nil {
 		c.logger.Error("Failed to delete schedule", "id", req.Id, "error", err)
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	return &scalehandlerv1.DeleteResponse{
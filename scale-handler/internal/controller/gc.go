@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"context"
+
+	"scale-handler/internal/controller/converter"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (c *Controller) CollectGarbage(ctx context.Context, req *scalehandlerv1.CollectGarbageRequest) (*scalehandlerv1.CollectGarbageResponse, error) {
+	c.logger.Info("Handling CollectGarbage request", "dry_run", req.DryRun)
+
+	if c.collector == nil {
+		return nil, status.Error(codes.FailedPrecondition, "scaling backend does not support garbage collection")
+	}
+
+	report, err := c.collector.RunOnce(ctx, req.DryRun)
+	if err != nil {
+		c.logger.Error("Failed to collect garbage", "error", err)
+		return nil, toStatusError(err)
+	}
+
+	return converter.GCReportToProto(report), nil
+}
@@ -14,7 +14,7 @@ func (c *Controller) Get(ctx context.Context, req *scalehandlerv1.GetRequest) (*
 	schedule, err := c.scheduleUC.GetSchedule(ctx, req.Id)
 	if err != nil {
 		c.logger.Error("Failed to get schedule", "id", req.Id, "error", err)
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	protoSchedule := converter.DomainToProto(schedule)
@@ -23,5 +23,6 @@ func (c *Controller) Get(ctx context.Context, req *scalehandlerv1.GetRequest) (*
 	return &scalehandlerv1.GetResponse{
 		Schedule:    protoSchedule,
 		Application: protoApplication,
+		Status:      converter.StatusToProto(schedule),
 	}, nil
 }
@@ -14,7 +14,7 @@ func (c *Controller) List(ctx context.Context, req *scalehandlerv1.ListRequest)
 	schedules, err := c.scheduleUC.ListSchedules(ctx)
 	if err != nil {
 		c.logger.Error("Failed to list schedules", "error", err)
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
 	items := make([]*scalehandlerv1.ScheduleWithApplication, len(schedules))
@@ -22,6 +22,8 @@ func (c *Controller) List(ctx context.Context, req *scalehandlerv1.ListRequest)
 		items[i] = &scalehandlerv1.ScheduleWithApplication{
 			Schedule:    converter.DomainToProto(s),
 			Application: converter.ApplicationToProto(s.Application),
+			Status:      converter.StatusToProto(s),
+			Id:          s.ID,
 		}
 	}
 
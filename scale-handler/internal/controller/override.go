@@ -0,0 +1,76 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"scale-handler/internal/controller/converter"
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func (c *Controller) CreateOverride(ctx context.Context, req *scalehandlerv1.CreateOverrideRequest) (*scalehandlerv1.CreateOverrideResponse, error) {
+	c.logger.Info("Handling CreateOverride request", "schedule_id", req.ScheduleId, "replicas", req.Replicas)
+
+	override, err := converter.ProtoToOverride(req)
+	if err != nil {
+		return nil, toStatusError(fmt.Errorf("invalid override time: %v: %w", err, domain.ErrInvalidArgument))
+	}
+
+	created, err := c.scheduleUC.CreateOverride(ctx, override)
+	if err != nil {
+		c.logger.Error("Failed to create override", "schedule_id", req.ScheduleId, "error", err)
+		return nil, toStatusError(err)
+	}
+	c.reapplyOverrides(ctx, req.ScheduleId)
+
+	return &scalehandlerv1.CreateOverrideResponse{
+		Override: converter.OverrideToProto(*created, time.Now()),
+	}, nil
+}
+
+func (c *Controller) ListOverrides(ctx context.Context, req *scalehandlerv1.ListOverridesRequest) (*scalehandlerv1.ListOverridesResponse, error) {
+	c.logger.Info("Handling ListOverrides request", "schedule_id", req.ScheduleId)
+
+	overrides, err := c.scheduleUC.ListOverrides(ctx, req.ScheduleId)
+	if err != nil {
+		c.logger.Error("Failed to list overrides", "schedule_id", req.ScheduleId, "error", err)
+		return nil, toStatusError(err)
+	}
+
+	now := time.Now()
+	items := make([]*scalehandlerv1.Override, len(overrides))
+	for i, o := range overrides {
+		items[i] = converter.OverrideToProto(o, now)
+	}
+
+	return &scalehandlerv1.ListOverridesResponse{
+		Items: items,
+	}, nil
+}
+
+func (c *Controller) CancelOverride(ctx context.Context, req *scalehandlerv1.CancelOverrideRequest) (*scalehandlerv1.CancelOverrideResponse, error) {
+	c.logger.Info("Handling CancelOverride request", "schedule_id", req.ScheduleId, "id", req.Id)
+
+	if err := c.scheduleUC.CancelOverride(ctx, req.ScheduleId, req.Id); err != nil {
+		c.logger.Error("Failed to cancel override", "schedule_id", req.ScheduleId, "id", req.Id, "error", err)
+		return nil, toStatusError(err)
+	}
+	c.reapplyOverrides(ctx, req.ScheduleId)
+
+	return &scalehandlerv1.CancelOverrideResponse{
+		Success: true,
+	}, nil
+}
+
+// reapplyOverrides перечитывает расписание вместе с переопределениями и
+// обновляет ScaledObject
+func (c *Controller) reapplyOverrides(ctx context.Context, scheduleID string) {
+	schedule, err := c.scheduleUC.GetSchedule(ctx, scheduleID)
+	if err != nil {
+		c.logger.Error("Failed to get schedule", "id", scheduleID, "error", err)
+		return
+	}
+	c.reapplyResources(ctx, schedule)
+}
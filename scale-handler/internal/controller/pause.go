@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"context"
+
+	"scale-handler/internal/controller/converter"
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+func (c *Controller) Pause(ctx context.Context, req *scalehandlerv1.PauseRequest) (*scalehandlerv1.PauseResponse, error) {
+	c.logger.Info("Handling Pause request", "id", req.Id, "paused_by", req.PausedBy)
+
+	schedule, err := c.scheduleUC.GetSchedule(ctx, req.Id)
+	if err != nil {
+		c.logger.Error("Failed to get schedule", "id", req.Id, "error", err)
+		return nil, toStatusError(err)
+	}
+
+	pause := domain.PauseState{
+		PausedBy: req.PausedBy,
+		Reason:   req.Reason,
+	}
+	if req.Replicas != nil {
+		pause.Replicas = *req.Replicas
+	} else {
+		// Без явного числа workload остаётся на текущем
+		pause.Replicas, err = c.reconciler.CurrentReplicas(ctx, schedule)
+		if err != nil {
+			c.logger.Error("Failed to get current replicas", "id", req.Id, "error", err)
+			return nil, toStatusError(err)
+		}
+	}
+
+	schedule, err = c.scheduleUC.PauseSchedule(ctx, req.Id, pause)
+	if err != nil {
+		c.logger.Error("Failed to pause schedule", "id", req.Id, "error", err)
+		return nil, toStatusError(err)
+	}
+	c.reapplyResources(ctx, schedule)
+
+	return &scalehandlerv1.PauseResponse{
+		Status: converter.StatusToProto(schedule),
+	}, nil
+}
+
+func (c *Controller) Resume(ctx context.Context, req *scalehandlerv1.ResumeRequest) (*scalehandlerv1.ResumeResponse, error) {
+	c.logger.Info("Handling Resume request", "id", req.Id)
+
+	schedule, err := c.scheduleUC.ResumeSchedule(ctx, req.Id)
+	if err != nil {
+		c.logger.Error("Failed to resume schedule", "id", req.Id, "error", err)
+		return nil, toStatusError(err)
+	}
+	c.reapplyResources(ctx, schedule)
+
+	return &scalehandlerv1.ResumeResponse{
+		Status: converter.StatusToProto(schedule),
+	}, nil
+}
@@ -0,0 +1,57 @@
+package controller
+
+import (
+	"context"
+
+	"scale-handler/internal/controller/converter"
+	"scale-handler/internal/domain"
+	scalehandlerv1 "scale-handler/pkg/api/proto/scale-handler"
+)
+
+// Render возвращает объекты сохранённого расписания, не обращаясь к кластеру
+func (c *Controller) Render(ctx context.Context, req *scalehandlerv1.RenderRequest) (*scalehandlerv1.RenderResponse, error) {
+	c.logger.Info("Handling Render request", "id", req.Id, "format", req.Format)
+
+	schedule, err := c.scheduleUC.GetSchedule(ctx, req.Id)
+	if err != nil {
+		c.logger.Error("Failed to get schedule", "id", req.Id, "error", err)
+		return nil, toStatusError(err)
+	}
+
+	objects, err := c.reconciler.Render(schedule)
+	if err != nil {
+		c.logger.Error("Failed to render schedule", "id", req.Id, "error", err)
+		return nil, toStatusError(err)
+	}
+	manifests, err := converter.ManifestsToProto(objects, req.Format)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &scalehandlerv1.RenderResponse{
+		Manifests: manifests,
+	}, nil
+}
+
+// dryRun проверяет расписание так же, как при сохранении, и прогоняет его
+// объекты через server-side dry-run. Ни БД, ни кластер не меняются.
+// previous - сохранённая версия при обновлении.
+func (c *Controller) dryRun(ctx context.Context, schedule, previous *domain.Schedule, format string) ([]*scalehandlerv1.Manifest, error) {
+	if err := c.scheduleUC.ValidateSchedule(schedule, previous); err != nil {
+		return nil, toStatusError(err)
+	}
+	if err := c.reconciler.ValidateReferences(ctx, schedule); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	objects, err := c.reconciler.DryRun(ctx, schedule)
+	if err != nil {
+		c.logger.Error("Dry-run failed", "id", schedule.ID, "error", err)
+		return nil, toStatusError(err)
+	}
+	manifests, err := converter.ManifestsToProto(objects, format)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return manifests, nil
+}
@@ -10,20 +10,58 @@ import (
 func (c *Controller) Update(ctx context.Context, req *scalehandlerv1.UpdateRequest) (*scalehandlerv1.UpdateResponse, error) {
 	c.logger.Info("Handling Update request", "id", req.Id)
 
-	rules := converter.ProtoToDomainRules(req.Schedule)
-	application := converter.ProtoToApplication(req.Application)
+	previous, err := c.scheduleUC.GetSchedule(ctx, req.Id)
+	if err != nil {
+		c.logger.Error("Failed to get schedule", "id", req.Id, "error", err)
+		return nil, toStatusError(err)
+	}
+
+	schedule := converter.ProtoToDomain(req.Schedule, req.Application)
+	schedule.ID = req.Id
+
+	if req.DryRun {
+		// Пауза и переопределения при обновлении сохраняются
+		schedule.Generation = previous.Generation + 1
+		schedule.Pause, schedule.Overrides = previous.Pause, previous.Overrides
+		manifests, err := c.dryRun(ctx, schedule, previous, req.Format)
+		if err != nil {
+			return nil, err
+		}
+		return &scalehandlerv1.UpdateResponse{
+			Success:   true,
+			Manifests: manifests,
+		}, nil
+	}
 
-	schedule, err := c.scheduleUC.UpdateSchedule(ctx, req.Id, rules, application)
+	// Ссылки проверяются только после namespace по умолчанию и allow-list
+	if err := c.scheduleUC.ValidateSchedule(schedule, previous); err != nil {
+		c.logger.Error("Invalid schedule", "id", req.Id, "error", err)
+		return nil, toStatusError(err)
+	}
+	if err := c.reconciler.ValidateReferences(ctx, schedule); err != nil {
+		c.logger.Error("Invalid object references", "id", req.Id, "error", err)
+		return nil, toStatusError(err)
+	}
+
+	schedule, err = c.scheduleUC.UpdateSchedule(ctx, schedule)
 	if err != nil {
 		c.logger.Error("Failed to update schedule", "id", req.Id, "error", err)
-		return nil, err
+		return nil, toStatusError(err)
 	}
 
-	if c.k8sReconciler != nil {
-		if err := c.k8sReconciler.UpdateResources(ctx, schedule); err != nil {
-			c.logger.Error("Failed to update K8s resources", "id", schedule.ID, "error", err)
+	// При смене namespace, вида workload или переходе между Application
+	// и target старые ресурсы больше не нужны
+	if previous.Namespace != schedule.Namespace || (previous.Target == nil) != (schedule.Target == nil) ||
+		previous.Application.WorkloadKind() != schedule.Application.WorkloadKind() {
+		if err := c.reconciler.DeleteResources(ctx, previous); err != nil {
+			c.logger.Error("Failed to delete previous K8s resources", "id", schedule.ID, "namespace", previous.Namespace, "error", err)
 		}
 	}
+	err = c.reconciler.UpdateResources(ctx, schedule)
+	if err != nil {
+		c.logger.Error("Failed to update K8s resources", "id", schedule.ID, "error", err)
+	}
+	c.recordApplyResult(ctx, schedule, err)
 
 	return &scalehandlerv1.UpdateResponse{
 		Success: true,
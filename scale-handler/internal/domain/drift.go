@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Действия над ресурсами кластера при сверке
+const (
+	ActionCreated = "created"
+	ActionUpdated = "updated"
+	ActionDeleted = "deleted"
+)
+
+// ResourceChange - изменение ресурса, внесённое при сверке с расписанием
+type ResourceChange struct {
+	ScheduleID string
+	Kind       string
+	Namespace  string
+	Name       string
+	Action     string
+}
+
+// DriftReport - итог одного прохода сверки
+type DriftReport struct {
+	StartedAt time.Time
+	Duration  time.Duration
+	Checked   int
+	Changes   []ResourceChange
+	Failed    map[string]error // по ID расписания
+}
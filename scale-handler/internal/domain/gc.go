@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// ActionMarked - объект помечен осиротевшим и будет удалён после grace-периода
+const ActionMarked = "marked"
+
+// ManagedResource - объект кластера, созданный сервисом для расписания
+type ManagedResource struct {
+	ScheduleID string
+	Kind       string
+	Namespace  string
+	Name       string
+	OrphanedAt time.Time // нулевое значение - объект ещё не помечен
+}
+
+// OrphanedResource - управляемый объект, для которого нет расписания
+type OrphanedResource struct {
+	ManagedResource
+	Action string // пусто - только найден
+}
+
+// GCReport - итог одного прохода сборки мусора
+type GCReport struct {
+	StartedAt time.Time
+	Duration  time.Duration
+	DryRun    bool
+	Checked   int
+	Orphans   []OrphanedResource
+	Failed    map[string]error // по "kind namespace/name"
+}
@@ -0,0 +1,10 @@
+package domain
+
+// Manifest - объект, который бэкенд масштабирования применил бы для расписания
+type Manifest struct {
+	APIVersion string
+	Kind       string
+	Namespace  string
+	Name       string
+	Content    []byte // JSON
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// Override - временное ручное число реплик поверх окон расписания. Как и
+// окна, задаёт нижнюю границу: HPA берёт максимум по всем триггерам.
+// После End расписание возвращается к обычному поведению само.
+type Override struct {
+	ID         string    `json:"id"`
+	ScheduleID string    `json:"scheduleId"`
+	Replicas   int32     `json:"replicas"`
+	Start      time.Time `json:"start"`
+	End        time.Time `json:"end"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// Active сообщает, действует ли переопределение в момент now
+func (o Override) Active(now time.Time) bool {
+	return !now.Before(o.Start) && now.Before(o.End)
+}
@@ -6,16 +6,82 @@ import (
 
 type Schedule struct {
 	ID          string
+	Namespace   string
 	Rules       ScheduleRules
 	Application *Application
+	Target      *TargetRef        // существующий workload вместо Application
+	Labels      map[string]string // добавляются ко всем создаваемым объектам
+	Annotations map[string]string // добавляются ко всем создаваемым объектам
+	Generation  int64             // увеличивается при каждом изменении расписания
+	Pause       *PauseState       // nil - расписание активно
+	Overrides   []Override        // ещё не истёкшие ручные переопределения
+	Status      ScheduleStatus
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// TargetRef - ссылка на workload, развёрнутый вне scale-handler.
+// Такой workload только масштабируется: он не создаётся и не удаляется.
+type TargetRef struct {
+	APIVersion string `json:"apiVersion,omitempty"` // обязателен для видов кроме Deployment и StatefulSet
+	Kind       string `json:"kind"`                 // по умолчанию Deployment, нужен подресурс /scale
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
+// PauseState - приостановка расписания: KEDA держит workload на Replicas и
+// не масштабирует его, пока расписание не возобновят
+type PauseState struct {
+	Replicas int32
+	PausedBy string
+	Reason   string
+	PausedAt time.Time
+}
+
+// Фазы применения расписания в кластере
+const (
+	PhasePending  = "Pending"
+	PhaseApplied  = "Applied"
+	PhaseDegraded = "Degraded"
+)
+
+// ScheduleStatus - результат последнего применения расписания в кластере
+type ScheduleStatus struct {
+	Phase             string
+	LastAppliedAt     *time.Time
+	LastError         string
+	AppliedGeneration int64
+}
+
 type ScheduleRules struct {
-	Weekdays   map[string][]TimeRange `json:"weekdays"`
-	Dates      map[string][]TimeRange `json:"dates"`
-	Exceptions []string               `json:"exceptions"`
+	Weekdays        map[string][]TimeRange `json:"weekdays"`
+	Dates           map[string][]TimeRange `json:"dates"`
+	Exceptions      []string               `json:"exceptions"`
+	Timezone        string                 `json:"timezone,omitempty"`
+	Scaling         *ScalingOptions        `json:"scaling,omitempty"`
+	DefaultReplicas int32                  `json:"defaultReplicas,omitempty"` // реплик, когда ни одно окно не активно
+	Triggers        []MetricTrigger        `json:"triggers,omitempty"`
+}
+
+// Границы реплик ScaledObject по умолчанию
+const (
+	DefaultMinReplicaCount = 0
+	DefaultMaxReplicaCount = 100
+)
+
+// ReplicaBounds возвращает действующие minReplicaCount и maxReplicaCount.
+// Вне окон HPA держит minReplicaCount, поэтому он не ниже DefaultReplicas.
+func (r *ScheduleRules) ReplicaBounds() (minReplicas, maxReplicas int32) {
+	minReplicas, maxReplicas = DefaultMinReplicaCount, DefaultMaxReplicaCount
+	if r.Scaling != nil {
+		if r.Scaling.MinReplicaCount != nil {
+			minReplicas = *r.Scaling.MinReplicaCount
+		}
+		if r.Scaling.MaxReplicaCount != nil {
+			maxReplicas = *r.Scaling.MaxReplicaCount
+		}
+	}
+	return max(minReplicas, r.DefaultReplicas), maxReplicas
 }
 
 type TimeRange struct {
@@ -24,28 +90,350 @@ type TimeRange struct {
 	Replicas int32  `json:"replicas"`
 }
 
+// ScalingOptions - параметры ScaledObject KEDA. Незаданные поля получают
+// значения по умолчанию при построении ScaledObject.
+type ScalingOptions struct {
+	MinReplicaCount *int32           `json:"minReplicaCount,omitempty"`
+	MaxReplicaCount *int32           `json:"maxReplicaCount,omitempty"`
+	CooldownPeriod  *int32           `json:"cooldownPeriod,omitempty"`
+	PollingInterval *int32           `json:"pollingInterval,omitempty"`
+	Fallback        *Fallback        `json:"fallback,omitempty"`
+	Behavior        *ScalingBehavior `json:"behavior,omitempty"`
+}
+
+type Fallback struct {
+	FailureThreshold int32 `json:"failureThreshold"`
+	Replicas         int32 `json:"replicas"`
+}
+
+// ScalingBehavior - advanced.horizontalPodAutoscalerConfig.behavior
+type ScalingBehavior struct {
+	ScaleUp   *ScalingRules `json:"scaleUp,omitempty"`
+	ScaleDown *ScalingRules `json:"scaleDown,omitempty"`
+}
+
+type ScalingRules struct {
+	StabilizationWindowSeconds *int32          `json:"stabilizationWindowSeconds,omitempty"`
+	SelectPolicy               string          `json:"selectPolicy,omitempty"` // Max, Min, Disabled
+	Policies                   []ScalingPolicy `json:"policies,omitempty"`
+}
+
+type ScalingPolicy struct {
+	Type          string `json:"type"` // Pods, Percent
+	Value         int32  `json:"value"`
+	PeriodSeconds int32  `json:"periodSeconds"`
+}
+
+// MetricTrigger - триггер KEDA по метрике. HPA берёт максимум по всем
+// триггерам, поэтому окна расписания задают нижнюю границу реплик, а
+// метрические триггеры масштабируют выше неё.
+type MetricTrigger struct {
+	Type              string             `json:"type"` // cpu, memory, prometheus, rabbitmq, kafka, aws-sqs-queue, redis
+	Name              string             `json:"name,omitempty"`
+	MetricType        string             `json:"metricType,omitempty"` // Utilization, AverageValue, Value
+	Metadata          map[string]string  `json:"metadata"`
+	AuthenticationRef *AuthenticationRef `json:"authenticationRef,omitempty"`
+}
+
+// AuthenticationRef - TriggerAuthentication с учётными данными для триггера
+type AuthenticationRef struct {
+	Name string `json:"name"`
+	Kind string `json:"kind,omitempty"` // TriggerAuthentication (по умолчанию), ClusterTriggerAuthentication
+}
+
+// Виды workload, которые создаются из Application
+const (
+	KindDeployment  = "Deployment"
+	KindStatefulSet = "StatefulSet"
+)
+
 type Application struct {
-	Containers []Container `json:"containers"`
+	Kind       string       `json:"kind,omitempty"` // Deployment (по умолчанию) или StatefulSet
+	Containers []Container  `json:"containers"`
+	Service    *ServiceSpec `json:"service,omitempty"`
+	Ingress    *IngressSpec `json:"ingress,omitempty"` // требует Service
+	Volumes    []Volume     `json:"volumes,omitempty"`
+
+	// Размещение подов
+	NodeSelector              map[string]string          `json:"nodeSelector,omitempty"`
+	Tolerations               []Toleration               `json:"tolerations,omitempty"`
+	Affinity                  *Affinity                  `json:"affinity,omitempty"`
+	TopologySpreadConstraints []TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	PriorityClassName         string                     `json:"priorityClassName,omitempty"`
+
+	// Параметры пода
+	InitContainers                []Container         `json:"initContainers,omitempty"`
+	ImagePullSecrets              []string            `json:"imagePullSecrets,omitempty"`
+	ServiceAccountName            string              `json:"serviceAccountName,omitempty"`
+	TerminationGracePeriodSeconds *int64              `json:"terminationGracePeriodSeconds,omitempty"`
+	SecurityContext               *PodSecurityContext `json:"securityContext,omitempty"`
+}
+
+// PodSecurityContext - настройки безопасности, общие для всех контейнеров пода
+type PodSecurityContext struct {
+	RunAsUser    *int64 `json:"runAsUser,omitempty"`
+	RunAsGroup   *int64 `json:"runAsGroup,omitempty"`
+	RunAsNonRoot *bool  `json:"runAsNonRoot,omitempty"`
+	FSGroup      *int64 `json:"fsGroup,omitempty"`
+}
+
+type Toleration struct {
+	Key               string `json:"key,omitempty"`
+	Operator          string `json:"operator,omitempty"` // Equal (по умолчанию) или Exists
+	Value             string `json:"value,omitempty"`
+	Effect            string `json:"effect,omitempty"` // NoSchedule, PreferNoSchedule, NoExecute
+	TolerationSeconds *int64 `json:"tolerationSeconds,omitempty"`
+}
+
+type Affinity struct {
+	NodeAffinity    *NodeAffinity `json:"nodeAffinity,omitempty"`
+	PodAffinity     *PodAffinity  `json:"podAffinity,omitempty"`
+	PodAntiAffinity *PodAffinity  `json:"podAntiAffinity,omitempty"`
+}
+
+// NodeAffinity - Required обязателен для размещения (термы объединяются
+// через ИЛИ), Preferred лишь повышает приоритет узлов
+type NodeAffinity struct {
+	Required  []NodeSelectorTerm        `json:"required,omitempty"`
+	Preferred []PreferredSchedulingTerm `json:"preferred,omitempty"`
+}
+
+type NodeSelectorTerm struct {
+	MatchExpressions []SelectorRequirement `json:"matchExpressions"`
+}
+
+type PreferredSchedulingTerm struct {
+	Weight     int32            `json:"weight"` // 1-100
+	Preference NodeSelectorTerm `json:"preference"`
+}
+
+// SelectorRequirement - условие на метку узла или пода
+type SelectorRequirement struct {
+	Key      string   `json:"key"`
+	Operator string   `json:"operator"` // In, NotIn, Exists, DoesNotExist, а для узлов ещё Gt, Lt
+	Values   []string `json:"values,omitempty"`
+}
+
+type PodAffinity struct {
+	Required  []PodAffinityTerm         `json:"required,omitempty"`
+	Preferred []WeightedPodAffinityTerm `json:"preferred,omitempty"`
+}
+
+// PodAffinityTerm - без LabelSelector выбираются поды самого приложения
+type PodAffinityTerm struct {
+	LabelSelector *LabelSelector `json:"labelSelector,omitempty"`
+	TopologyKey   string         `json:"topologyKey"`
+	Namespaces    []string       `json:"namespaces,omitempty"`
+}
+
+type WeightedPodAffinityTerm struct {
+	Weight int32           `json:"weight"` // 1-100
+	Term   PodAffinityTerm `json:"term"`
+}
+
+type LabelSelector struct {
+	MatchLabels      map[string]string     `json:"matchLabels,omitempty"`
+	MatchExpressions []SelectorRequirement `json:"matchExpressions,omitempty"`
+}
+
+// TopologySpreadConstraint - без LabelSelector распределяются поды самого
+// приложения
+type TopologySpreadConstraint struct {
+	MaxSkew           int32          `json:"maxSkew"`
+	TopologyKey       string         `json:"topologyKey"`                 // например topology.kubernetes.io/zone
+	WhenUnsatisfiable string         `json:"whenUnsatisfiable,omitempty"` // DoNotSchedule (по умолчанию) или ScheduleAnyway
+	LabelSelector     *LabelSelector `json:"labelSelector,omitempty"`
+	MinDomains        int32          `json:"minDomains,omitempty"`
+}
+
+// ServiceSpec - Service перед workload. Без портов публикуются все порты
+// контейнеров.
+type ServiceSpec struct {
+	Type  string        `json:"type,omitempty"` // ClusterIP (по умолчанию), NodePort, LoadBalancer
+	Ports []ServicePort `json:"ports,omitempty"`
+}
+
+type ServicePort struct {
+	Name       string `json:"name,omitempty"`
+	Port       int32  `json:"port"`
+	TargetPort int32  `json:"targetPort,omitempty"` // по умолчанию равен port
+	Protocol   string `json:"protocol,omitempty"`
+}
+
+// IngressSpec - HTTP-маршрут снаружи кластера к Service
+type IngressSpec struct {
+	ClassName     string `json:"className,omitempty"`
+	Host          string `json:"host"`
+	Path          string `json:"path,omitempty"`        // по умолчанию "/"
+	PathType      string `json:"pathType,omitempty"`    // Prefix (по умолчанию), Exact, ImplementationSpecific
+	ServicePort   int32  `json:"servicePort,omitempty"` // по умолчанию первый порт Service
+	TLSSecretName string `json:"tlsSecretName,omitempty"`
+}
+
+// AllContainers возвращает init-контейнеры и основные контейнеры
+func (a *Application) AllContainers() []Container {
+	if a == nil {
+		return nil
+	}
+	return append(append([]Container(nil), a.InitContainers...), a.Containers...)
+}
+
+// WorkloadKind возвращает вид workload с учётом значения по умолчанию
+func (a *Application) WorkloadKind() string {
+	if a == nil || a.Kind == "" {
+		return KindDeployment
+	}
+	return a.Kind
 }
 
 type Container struct {
 	Name            string           `json:"name"`
 	Image           string           `json:"image"`
+	Command         []string         `json:"command,omitempty"` // заменяет ENTRYPOINT образа
+	Args            []string         `json:"args,omitempty"`    // заменяет CMD образа
+	ImagePullPolicy string           `json:"imagePullPolicy,omitempty"`
+	SecurityContext *SecurityContext `json:"securityContext,omitempty"`
+	Lifecycle       *Lifecycle       `json:"lifecycle,omitempty"`
 	Ports           []ContainerPort  `json:"ports,omitempty"`
 	Env             []EnvVar         `json:"env,omitempty"`
+	EnvFrom         []EnvFromSource  `json:"envFrom,omitempty"`
 	Resources       *Resources       `json:"resources,omitempty"`
 	LivenessProbe   *Probe           `json:"livenessProbe,omitempty"`
 	ReadinessProbe  *Probe           `json:"readinessProbe,omitempty"`
+	StartupProbe    *Probe           `json:"startupProbe,omitempty"`
+	VolumeMounts    []VolumeMount    `json:"volumeMounts,omitempty"`
+}
+
+type SecurityContext struct {
+	RunAsUser                *int64        `json:"runAsUser,omitempty"`
+	RunAsGroup               *int64        `json:"runAsGroup,omitempty"`
+	RunAsNonRoot             *bool         `json:"runAsNonRoot,omitempty"`
+	ReadOnlyRootFilesystem   *bool         `json:"readOnlyRootFilesystem,omitempty"`
+	AllowPrivilegeEscalation *bool         `json:"allowPrivilegeEscalation,omitempty"`
+	Privileged               *bool         `json:"privileged,omitempty"`
+	Capabilities             *Capabilities `json:"capabilities,omitempty"`
+}
+
+type Capabilities struct {
+	Add  []string `json:"add,omitempty"`
+	Drop []string `json:"drop,omitempty"`
+}
+
+// Lifecycle - хуки контейнера. PreStop даёт приложению завершить запросы
+// перед остановкой пода при уменьшении реплик.
+type Lifecycle struct {
+	PostStart *LifecycleHandler `json:"postStart,omitempty"`
+	PreStop   *LifecycleHandler `json:"preStop,omitempty"`
+}
+
+// LifecycleHandler - задаётся ровно одно действие
+type LifecycleHandler struct {
+	Exec    *ExecAction    `json:"exec,omitempty"`
+	HTTPGet *HTTPGetAction `json:"httpGet,omitempty"`
 }
 
 type ContainerPort struct {
 	ContainerPort int    `json:"containerPort"`
-	Protocol     string `json:"protocol,omitempty"`
+	Protocol      string `json:"protocol,omitempty"`
 }
 
+// EnvVar - переменная окружения: либо значение, либо ссылка в ValueFrom.
+// Секреты передаются ссылкой, чтобы не хранить их в базе.
 type EnvVar struct {
-	Name  string `json:"name"`
-	Value string `json:"value"`
+	Name      string        `json:"name"`
+	Value     string        `json:"value,omitempty"`
+	ValueFrom *EnvVarSource `json:"valueFrom,omitempty"`
+}
+
+type EnvVarSource struct {
+	SecretKeyRef    *KeySelector `json:"secretKeyRef,omitempty"`
+	ConfigMapKeyRef *KeySelector `json:"configMapKeyRef,omitempty"`
+	FieldRef        *FieldRef    `json:"fieldRef,omitempty"`
+}
+
+// KeySelector - ключ в Secret или ConfigMap того же namespace
+type KeySelector struct {
+	Name     string `json:"name"`
+	Key      string `json:"key"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// FieldRef - поле пода, например metadata.name или status.podIP
+type FieldRef struct {
+	FieldPath string `json:"fieldPath"`
+}
+
+// EnvFromSource - все ключи Secret или ConfigMap как переменные окружения
+type EnvFromSource struct {
+	Prefix       string          `json:"prefix,omitempty"`
+	SecretRef    *LocalObjectRef `json:"secretRef,omitempty"`
+	ConfigMapRef *LocalObjectRef `json:"configMapRef,omitempty"`
+}
+
+type LocalObjectRef struct {
+	Name     string `json:"name"`
+	Optional bool   `json:"optional,omitempty"`
+}
+
+// Volume - том пода, задаётся ровно один источник
+type Volume struct {
+	Name                  string                 `json:"name"`
+	EmptyDir              *EmptyDirVolume        `json:"emptyDir,omitempty"`
+	ConfigMap             *ObjectVolume          `json:"configMap,omitempty"`
+	Secret                *ObjectVolume          `json:"secret,omitempty"`
+	PersistentVolumeClaim *PersistentVolumeClaim `json:"persistentVolumeClaim,omitempty"`
+	Projected             *ProjectedVolume       `json:"projected,omitempty"`
+}
+
+type EmptyDirVolume struct {
+	Medium    string `json:"medium,omitempty"`    // "" (диск узла) или Memory
+	SizeLimit string `json:"sizeLimit,omitempty"` // например 1Gi
+}
+
+// ObjectVolume - ключи ConfigMap или Secret из namespace расписания в виде
+// файлов. Без Items монтируются все ключи.
+type ObjectVolume struct {
+	Name        string      `json:"name"`
+	Items       []KeyToPath `json:"items,omitempty"`
+	DefaultMode int32       `json:"defaultMode,omitempty"`
+	Optional    bool        `json:"optional,omitempty"`
+}
+
+type KeyToPath struct {
+	Key  string `json:"key"`
+	Path string `json:"path"`
+	Mode int32  `json:"mode,omitempty"`
+}
+
+type PersistentVolumeClaim struct {
+	ClaimName string `json:"claimName"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+// ProjectedVolume собирает несколько источников в один каталог
+type ProjectedVolume struct {
+	Sources     []VolumeProjection `json:"sources"`
+	DefaultMode int32              `json:"defaultMode,omitempty"`
+}
+
+// VolumeProjection - источник projected-тома, задаётся ровно одно поле
+type VolumeProjection struct {
+	ConfigMap           *ObjectVolume                  `json:"configMap,omitempty"`
+	Secret              *ObjectVolume                  `json:"secret,omitempty"`
+	ServiceAccountToken *ServiceAccountTokenProjection `json:"serviceAccountToken,omitempty"`
+}
+
+type ServiceAccountTokenProjection struct {
+	Audience          string `json:"audience,omitempty"`
+	ExpirationSeconds int64  `json:"expirationSeconds,omitempty"`
+	Path              string `json:"path"`
+}
+
+type VolumeMount struct {
+	Name      string `json:"name"`
+	MountPath string `json:"mountPath"`
+	SubPath   string `json:"subPath,omitempty"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
 }
 
 type Resources struct {
@@ -58,13 +446,42 @@ type ResourceQuantity struct {
 	CPU    string `json:"cpu,omitempty"`
 }
 
+// Probe - проверка контейнера, задаётся ровно одно действие. Нулевые
+// параметры означают значения Kubernetes по умолчанию.
 type Probe struct {
-	HTTPGet             *HTTPGetAction `json:"httpGet,omitempty"`
-	InitialDelaySeconds int32          `json:"initialDelaySeconds,omitempty"`
-	PeriodSeconds       int32          `json:"periodSeconds,omitempty"`
+	HTTPGet             *HTTPGetAction   `json:"httpGet,omitempty"`
+	TCPSocket           *TCPSocketAction `json:"tcpSocket,omitempty"`
+	Exec                *ExecAction      `json:"exec,omitempty"`
+	GRPC                *GRPCAction      `json:"grpc,omitempty"`
+	InitialDelaySeconds int32            `json:"initialDelaySeconds,omitempty"`
+	PeriodSeconds       int32            `json:"periodSeconds,omitempty"`
+	TimeoutSeconds      int32            `json:"timeoutSeconds,omitempty"`
+	FailureThreshold    int32            `json:"failureThreshold,omitempty"`
+	SuccessThreshold    int32            `json:"successThreshold,omitempty"` // для liveness и startup только 1
 }
 
 type HTTPGetAction struct {
-	Path string `json:"path"`
-	Port int32  `json:"port"`
+	Path        string       `json:"path"`
+	Port        int32        `json:"port"`
+	Scheme      string       `json:"scheme,omitempty"` // HTTP (по умолчанию) или HTTPS
+	HTTPHeaders []HTTPHeader `json:"httpHeaders,omitempty"`
+}
+
+type HTTPHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type TCPSocketAction struct {
+	Port int32 `json:"port"`
+}
+
+type ExecAction struct {
+	Command []string `json:"command"`
+}
+
+// GRPCAction - стандартная проверка grpc.health.v1
+type GRPCAction struct {
+	Port    int32  `json:"port"`
+	Service string `json:"service,omitempty"`
 }
@@ -0,0 +1,163 @@
+package gc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"scale-handler/internal/domain"
+	"scale-handler/internal/reconciler"
+	"scale-handler/internal/usecase"
+)
+
+// Collector находит объекты кластера с меткой сервиса, для которых нет
+// расписания, например после ручного удаления строки из БД или сбоя при
+// удалении, а также объекты в прежнем namespace перенесённого расписания.
+// Такой объект сначала помечается и удаляется только после
+// grace-периода, если за это время расписание не появилось.
+type Collector struct {
+	scheduleUC  *usecase.ScheduleUseCase
+	backend     reconciler.GarbageCollector
+	gracePeriod time.Duration
+	logger      *slog.Logger
+}
+
+func NewCollector(scheduleUC *usecase.ScheduleUseCase, backend reconciler.GarbageCollector, gracePeriod time.Duration, logger *slog.Logger) *Collector {
+	return &Collector{
+		scheduleUC:  scheduleUC,
+		backend:     backend,
+		gracePeriod: gracePeriod,
+		logger:      logger,
+	}
+}
+
+// Run выполняет сборку сразу и затем с заданным интервалом до отмены ctx.
+// В режиме dryRun осиротевшие объекты только попадают в лог.
+func (c *Collector) Run(ctx context.Context, interval time.Duration, dryRun bool) {
+	c.logger.Info("Garbage collector started", "interval", interval, "grace_period", c.gracePeriod, "dry_run", dryRun)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := c.RunOnce(ctx, dryRun); err != nil {
+			c.logger.Error("Garbage collection failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			c.logger.Info("Garbage collector stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce выполняет один проход сборки. В режиме dryRun объекты не
+// помечаются и не удаляются. Ошибка одного объекта не останавливает проход.
+func (c *Collector) RunOnce(ctx context.Context, dryRun bool) (*domain.GCReport, error) {
+	report := &domain.GCReport{
+		StartedAt: time.Now(),
+		DryRun:    dryRun,
+		Failed:    map[string]error{},
+	}
+
+	// Объекты читаются до расписаний: объект нового расписания создаётся
+	// после записи в БД, поэтому не может оказаться осиротевшим по ошибке
+	resources, err := c.backend.ListManaged(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedules, err := c.scheduleUC.ListSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make(map[string]string, len(schedules))
+	for _, schedule := range schedules {
+		namespaces[schedule.ID] = schedule.Namespace
+	}
+
+	for _, res := range resources {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		report.Checked++
+
+		if !orphaned(res, namespaces) {
+			// Расписание вернулось, например после восстановления БД
+			if !res.OrphanedAt.IsZero() && !dryRun {
+				if err := c.backend.MarkOrphaned(ctx, res, nil); err != nil {
+					report.Failed[resourceKey(res)] = err
+				}
+			}
+			continue
+		}
+
+		orphan := domain.OrphanedResource{ManagedResource: res}
+		if !dryRun {
+			orphan.Action, err = c.collect(ctx, res, report.StartedAt)
+			if err != nil {
+				report.Failed[resourceKey(res)] = err
+			}
+			if orphan.Action == domain.ActionMarked {
+				orphan.OrphanedAt = report.StartedAt
+			}
+		}
+		report.Orphans = append(report.Orphans, orphan)
+	}
+	report.Duration = time.Since(report.StartedAt)
+
+	c.logReport(report)
+	return report, nil
+}
+
+// orphaned сообщает, что объект не нужен ни одному расписанию: расписания нет
+// или оно перенесено в другой namespace, а старые объекты не удалились
+func orphaned(res domain.ManagedResource, namespaces map[string]string) bool {
+	ns, ok := namespaces[res.ScheduleID]
+	return !ok || ns != res.Namespace
+}
+
+// collect помечает объект при первом обнаружении и удаляет его, когда
+// grace-период истёк
+func (c *Collector) collect(ctx context.Context, res domain.ManagedResource, now time.Time) (string, error) {
+	if res.OrphanedAt.IsZero() && c.gracePeriod > 0 {
+		if err := c.backend.MarkOrphaned(ctx, res, &now); err != nil {
+			return "", err
+		}
+		return domain.ActionMarked, nil
+	}
+	if now.Sub(res.OrphanedAt) < c.gracePeriod {
+		return "", nil
+	}
+	if err := c.backend.DeleteOrphaned(ctx, res); err != nil {
+		return "", err
+	}
+	return domain.ActionDeleted, nil
+}
+
+func (c *Collector) logReport(report *domain.GCReport) {
+	for _, o := range report.Orphans {
+		c.logger.Warn("Orphaned resource",
+			"schedule_id", o.ScheduleID,
+			"kind", o.Kind,
+			"namespace", o.Namespace,
+			"name", o.Name,
+			"orphaned_at", o.OrphanedAt,
+			"action", o.Action)
+	}
+	for key, err := range report.Failed {
+		c.logger.Error("Garbage collection of resource failed", "resource", key, "error", err)
+	}
+	c.logger.Info("Garbage collection finished",
+		"checked", report.Checked,
+		"orphaned", len(report.Orphans),
+		"failed", len(report.Failed),
+		"dry_run", report.DryRun,
+		"duration", report.Duration)
+}
+
+func resourceKey(res domain.ManagedResource) string {
+	return fmt.Sprintf("%s %s/%s", res.Kind, res.Namespace, res.Name)
+}
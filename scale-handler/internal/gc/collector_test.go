@@ -0,0 +1,83 @@
+package gc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"scale-handler/internal/config"
+	"scale-handler/internal/domain"
+	"scale-handler/internal/reconciler/memory"
+)
+
+func TestOrphaned(t *testing.T) {
+	namespaces := map[string]string{"a": "team-a"}
+
+	tests := []struct {
+		name string
+		res  domain.ManagedResource
+		want bool
+	}{
+		{"schedule exists", domain.ManagedResource{ScheduleID: "a", Namespace: "team-a"}, false},
+		{"schedule deleted", domain.ManagedResource{ScheduleID: "b", Namespace: "team-a"}, true},
+		{"schedule moved to another namespace", domain.ManagedResource{ScheduleID: "a", Namespace: "default"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := orphaned(tt.res, namespaces); got != tt.want {
+				t.Errorf("orphaned() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollect(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		gracePeriod time.Duration
+		orphanedAt  time.Time
+		want        string
+		wantKept    bool
+	}{
+		{"first sighting is marked", time.Hour, time.Time{}, domain.ActionMarked, true},
+		{"grace period not over", time.Hour, now.Add(-30 * time.Minute), "", true},
+		{"grace period over", time.Hour, now.Add(-2 * time.Hour), domain.ActionDeleted, false},
+		{"no grace period", 0, time.Time{}, domain.ActionDeleted, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend, err := memory.NewReconciler(config.K8sConfig{DefaultTimezone: "UTC", DefaultNamespace: "default"}, slog.Default())
+			if err != nil {
+				t.Fatal(err)
+			}
+			schedule := &domain.Schedule{ID: "a", Target: &domain.TargetRef{Name: "web"}}
+			if err := backend.CreateResources(ctx, schedule); err != nil {
+				t.Fatal(err)
+			}
+			resources, _ := backend.ListManaged(ctx)
+			res := resources[0]
+			if !tt.orphanedAt.IsZero() {
+				if err := backend.MarkOrphaned(ctx, res, &tt.orphanedAt); err != nil {
+					t.Fatal(err)
+				}
+				res.OrphanedAt = tt.orphanedAt
+			}
+
+			c := NewCollector(nil, backend, tt.gracePeriod, slog.Default())
+			action, err := c.collect(ctx, res, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if action != tt.want {
+				t.Errorf("collect() = %q, want %q", action, tt.want)
+			}
+			if kept := len(backend.Objects("a")) > 0; kept != tt.wantKept {
+				t.Errorf("object kept = %v, want %v", kept, tt.wantKept)
+			}
+		})
+	}
+}
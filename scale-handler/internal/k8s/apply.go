@@ -0,0 +1,58 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fieldManager - имя менеджера полей для server-side apply. Поля, которыми
+// владеют другие менеджеры (KEDA, HPA, люди), при apply не затираются.
+const fieldManager = "scale-handler"
+
+func deploymentGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "apps",
+		Version:  "v1",
+		Resource: "deployments",
+	}
+}
+
+// apply применяет объект через server-side apply. Конфликты с другими
+// менеджерами решаются в пользу scale-handler только для полей из obj.
+func (r *Reconciler) apply(ctx context.Context, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	client := r.dynamic.Resource(gvr).Namespace(obj.GetNamespace())
+	return client.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+		FieldManager: fieldManager,
+		Force:        true,
+	})
+}
+
+// applyDryRun выполняет apply на сервере без сохранения. Ответ проходит
+// admission-вебхуки и содержит значения по умолчанию.
+func (r *Reconciler) applyDryRun(ctx context.Context, gvr schema.GroupVersionResource, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	client := r.dynamic.Resource(gvr).Namespace(obj.GetNamespace())
+	return client.Apply(ctx, obj.GetName(), obj, metav1.ApplyOptions{
+		FieldManager: fieldManager,
+		Force:        true,
+		DryRun:       []string{metav1.DryRunAll},
+	})
+}
+
+// toApplyObject переводит типизированный объект в unstructured для apply,
+// убирая пустые поля, которые иначе попали бы в managedFields
+func toApplyObject(obj runtime.Object) (*unstructured.Unstructured, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, fmt.Errorf("convert to unstructured: %w", err)
+	}
+	u := &unstructured.Unstructured{Object: content}
+	unstructured.RemoveNestedField(u.Object, "status")
+	unstructured.RemoveNestedField(u.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(u.Object, "spec", "template", "metadata", "creationTimestamp")
+	return u, nil
+}
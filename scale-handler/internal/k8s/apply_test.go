@@ -0,0 +1,42 @@
+package k8s
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"scale-handler/internal/domain"
+)
+
+func TestToApplyObject(t *testing.T) {
+	app := &domain.Application{Containers: []domain.Container{{Name: "web", Image: "nginx:1.25"}}}
+	deployment, _, err := (&Renderer{}).buildWorkload(metav1.ObjectMeta{Name: "web", Namespace: "team-a"}, app, 2)
+	if err != nil {
+		t.Fatalf("buildWorkload() error = %v", err)
+	}
+
+	obj, err := toApplyObject(deployment)
+	if err != nil {
+		t.Fatalf("toApplyObject() error = %v", err)
+	}
+	if obj.GetAPIVersion() != "apps/v1" || obj.GetKind() != "Deployment" {
+		t.Errorf("apiVersion/kind = %s/%s, want apps/v1/Deployment", obj.GetAPIVersion(), obj.GetKind())
+	}
+	if obj.GetNamespace() != "team-a" || obj.GetName() != "web" {
+		t.Errorf("object = %s/%s, want team-a/web", obj.GetNamespace(), obj.GetName())
+	}
+	// Пустые поля не должны попадать в managedFields scale-handler
+	for _, path := range [][]string{
+		{"status"},
+		{"metadata", "creationTimestamp"},
+		{"spec", "template", "metadata", "creationTimestamp"},
+	} {
+		if _, found, _ := unstructured.NestedFieldNoCopy(obj.Object, path...); found {
+			t.Errorf("field %v is present", path)
+		}
+	}
+	if replicas, _, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); replicas != 2 {
+		t.Errorf("spec.replicas = %d, want 2", replicas)
+	}
+}
@@ -0,0 +1,156 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"scale-handler/internal/domain"
+)
+
+// ValidateReferences проверяет, что Secret и ConfigMap, на которые ссылаются
+// переменные окружения, тома и imagePullSecrets, есть в namespace расписания.
+// Ссылки с optional: true не проверяются.
+func (r *Reconciler) ValidateReferences(ctx context.Context, schedule *domain.Schedule) error {
+	if schedule.Application == nil {
+		return nil
+	}
+	ns := r.namespace(schedule)
+
+	secrets, configMaps := map[string]bool{}, map[string]bool{}
+	addVolume := func(refs map[string]bool, v *domain.ObjectVolume) {
+		if v != nil && !v.Optional {
+			refs[v.Name] = true
+		}
+	}
+	for _, v := range schedule.Application.Volumes {
+		addVolume(configMaps, v.ConfigMap)
+		addVolume(secrets, v.Secret)
+		if v.Projected != nil {
+			for _, s := range v.Projected.Sources {
+				addVolume(configMaps, s.ConfigMap)
+				addVolume(secrets, s.Secret)
+			}
+		}
+	}
+	for _, name := range schedule.Application.ImagePullSecrets {
+		secrets[name] = true
+	}
+	for _, c := range schedule.Application.AllContainers() {
+		for _, e := range c.Env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if ref := e.ValueFrom.SecretKeyRef; ref != nil && !ref.Optional {
+				secrets[ref.Name] = true
+			}
+			if ref := e.ValueFrom.ConfigMapKeyRef; ref != nil && !ref.Optional {
+				configMaps[ref.Name] = true
+			}
+		}
+		for _, e := range c.EnvFrom {
+			if ref := e.SecretRef; ref != nil && !ref.Optional {
+				secrets[ref.Name] = true
+			}
+			if ref := e.ConfigMapRef; ref != nil && !ref.Optional {
+				configMaps[ref.Name] = true
+			}
+		}
+	}
+
+	for _, name := range sortedNames(secrets) {
+		_, err := r.clientset.CoreV1().Secrets(ns).Get(ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("secret %s/%s not found: %w", ns, name, domain.ErrInvalidArgument)
+		}
+		if err != nil {
+			return fmt.Errorf("get secret %s/%s: %w", ns, name, err)
+		}
+	}
+	for _, name := range sortedNames(configMaps) {
+		_, err := r.clientset.CoreV1().ConfigMaps(ns).Get(ctx, name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return fmt.Errorf("configmap %s/%s not found: %w", ns, name, domain.ErrInvalidArgument)
+		}
+		if err != nil {
+			return fmt.Errorf("get configmap %s/%s: %w", ns, name, err)
+		}
+	}
+	return nil
+}
+
+func envToK8s(env []domain.EnvVar) []corev1.EnvVar {
+	if len(env) == 0 {
+		return nil
+	}
+	result := make([]corev1.EnvVar, len(env))
+	for i, e := range env {
+		result[i] = corev1.EnvVar{Name: e.Name, Value: e.Value}
+		src := e.ValueFrom
+		if src == nil {
+			continue
+		}
+		result[i].Value = ""
+		result[i].ValueFrom = &corev1.EnvVarSource{}
+		if ref := src.SecretKeyRef; ref != nil {
+			result[i].ValueFrom.SecretKeyRef = &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Key:                  ref.Key,
+				Optional:             optionalPtr(ref.Optional),
+			}
+		}
+		if ref := src.ConfigMapKeyRef; ref != nil {
+			result[i].ValueFrom.ConfigMapKeyRef = &corev1.ConfigMapKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Key:                  ref.Key,
+				Optional:             optionalPtr(ref.Optional),
+			}
+		}
+		if ref := src.FieldRef; ref != nil {
+			result[i].ValueFrom.FieldRef = &corev1.ObjectFieldSelector{FieldPath: ref.FieldPath}
+		}
+	}
+	return result
+}
+
+func envFromToK8s(envFrom []domain.EnvFromSource) []corev1.EnvFromSource {
+	var result []corev1.EnvFromSource
+	for _, e := range envFrom {
+		src := corev1.EnvFromSource{Prefix: e.Prefix}
+		if ref := e.SecretRef; ref != nil {
+			src.SecretRef = &corev1.SecretEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Optional:             optionalPtr(ref.Optional),
+			}
+		}
+		if ref := e.ConfigMapRef; ref != nil {
+			src.ConfigMapRef = &corev1.ConfigMapEnvSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: ref.Name},
+				Optional:             optionalPtr(ref.Optional),
+			}
+		}
+		result = append(result, src)
+	}
+	return result
+}
+
+// optionalPtr не выводит optional: false, чтобы не расходиться с сервером
+func optionalPtr(optional bool) *bool {
+	if !optional {
+		return nil
+	}
+	return &optional
+}
+
+func sortedNames(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,136 @@
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"scale-handler/internal/domain"
+)
+
+// orphanedAtAnnotation - время, когда объект впервые найден без расписания
+const orphanedAtAnnotation = "cron-scaler.io/orphaned-at"
+
+// gcFieldManager ставит и снимает пометку отдельно от apply, чтобы очередной
+// apply расписания не считал аннотацию своей
+const gcFieldManager = "scale-handler-gc"
+
+// managedKinds - виды объектов, которые создаёт сервис. ScaledObject идёт
+// первым, чтобы KEDA не успела масштабировать удаляемый workload.
+var managedKinds = []struct {
+	kind string
+	gvr  func() schema.GroupVersionResource
+}{
+	{scaledObjectKind, scaledObjectGVR},
+	{"Deployment", deploymentGVR},
+	{"StatefulSet", statefulSetGVR},
+	{"Ingress", ingressGVR},
+	{"Service", serviceGVR},
+}
+
+// ListManaged возвращает объекты всех namespace с меткой managed-by сервиса.
+// Объекты, которыми не управляет scale-handler, пропускаются: метку мог
+// скопировать кто угодно.
+func (r *Reconciler) ListManaged(ctx context.Context) ([]domain.ManagedResource, error) {
+	selector := "app.kubernetes.io/managed-by=" + managedBy
+	var resources []domain.ManagedResource
+	for _, mk := range managedKinds {
+		list, err := r.dynamic.Resource(mk.gvr()).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return nil, fmt.Errorf("list %s: %w", mk.gvr().Resource, err)
+		}
+		for i := range list.Items {
+			obj := &list.Items[i]
+			id := scheduleIDOf(obj)
+			if id == "" || !managedByUs(obj) {
+				continue
+			}
+			res := domain.ManagedResource{
+				ScheduleID: id,
+				Kind:       mk.kind,
+				Namespace:  obj.GetNamespace(),
+				Name:       obj.GetName(),
+			}
+			if at, ok := obj.GetAnnotations()[orphanedAtAnnotation]; ok {
+				if t, err := time.Parse(time.RFC3339, at); err == nil {
+					res.OrphanedAt = t
+				}
+			}
+			resources = append(resources, res)
+		}
+	}
+	return resources, nil
+}
+
+// MarkOrphaned ставит на объект время обнаружения; nil снимает пометку
+func (r *Reconciler) MarkOrphaned(ctx context.Context, res domain.ManagedResource, at *time.Time) error {
+	var value interface{}
+	if at != nil {
+		value = at.UTC().Format(time.RFC3339)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{orphanedAtAnnotation: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	gvr, err := managedGVR(res.Kind)
+	if err != nil {
+		return err
+	}
+	_, err = r.dynamic.Resource(gvr).Namespace(res.Namespace).Patch(ctx, res.Name, types.MergePatchType, patch, metav1.PatchOptions{
+		FieldManager: gcFieldManager,
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("patch %s: %w", gvr.Resource, err)
+	}
+	return nil
+}
+
+// DeleteOrphaned удаляет осиротевший объект
+func (r *Reconciler) DeleteOrphaned(ctx context.Context, res domain.ManagedResource) error {
+	gvr, err := managedGVR(res.Kind)
+	if err != nil {
+		return err
+	}
+	if _, err := r.deleteOwned(ctx, gvr, res.Namespace, res.Name); err != nil {
+		return fmt.Errorf("delete %s: %w", gvr.Resource, err)
+	}
+	return nil
+}
+
+func managedGVR(kind string) (schema.GroupVersionResource, error) {
+	for _, mk := range managedKinds {
+		if mk.kind == kind {
+			return mk.gvr(), nil
+		}
+	}
+	return schema.GroupVersionResource{}, fmt.Errorf("unknown managed kind %s", kind)
+}
+
+// scheduleIDOf берёт ID расписания из аннотации, а если её сняли - из метки
+func scheduleIDOf(obj *unstructured.Unstructured) string {
+	if id := obj.GetAnnotations()[scheduleIDAnnotation]; id != "" {
+		return id
+	}
+	return obj.GetLabels()["app.kubernetes.io/instance"]
+}
+
+func managedByUs(obj *unstructured.Unstructured) bool {
+	for _, mf := range obj.GetManagedFields() {
+		if mf.Manager == fieldManager {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"scale-handler/internal/domain"
+)
+
+const (
+	managedBy            = "cron-scaler"
+	scheduleIDAnnotation = "cron-scaler.io/schedule-id"
+	revisionAnnotation   = "cron-scaler.io/revision"
+)
+
+// objectMeta строит метаданные объектов расписания. Пользовательские метки
+// и аннотации дополняются стандартными, которые переопределить нельзя.
+func (r *Renderer) objectMeta(schedule *domain.Schedule) metav1.ObjectMeta {
+	labels := make(map[string]string, len(schedule.Labels)+3)
+	maps.Copy(labels, schedule.Labels)
+	labels["app.kubernetes.io/name"] = schedule.ID
+	labels["app.kubernetes.io/instance"] = schedule.ID
+	labels["app.kubernetes.io/managed-by"] = managedBy
+
+	annotations := make(map[string]string, len(schedule.Annotations)+2)
+	maps.Copy(annotations, schedule.Annotations)
+	annotations[scheduleIDAnnotation] = schedule.ID
+	annotations[revisionAnnotation] = strconv.FormatInt(schedule.Generation, 10)
+
+	return metav1.ObjectMeta{
+		Name:        schedule.ID,
+		Namespace:   r.namespace(schedule),
+		Labels:      labels,
+		Annotations: annotations,
+	}
+}
+
+// podLabels - метки пода: метки объекта и селектор app. Аннотации в шаблон
+// пода не попадают, иначе каждая правка расписания перезапускала бы поды.
+func podLabels(meta metav1.ObjectMeta) map[string]string {
+	labels := maps.Clone(meta.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels["app"] = meta.Name
+	return labels
+}
+
+// workloadOwner возвращает ссылку на workload из Application для
+// ScaledObject: при удалении workload сборщик мусора удалит и ScaledObject
+func (r *Reconciler) workloadOwner(ctx context.Context, meta metav1.ObjectMeta, app *domain.Application) (metav1.OwnerReference, error) {
+	gvr := deploymentGVR()
+	if app.WorkloadKind() == domain.KindStatefulSet {
+		gvr = statefulSetGVR()
+	}
+	workload, err := r.dynamic.Resource(gvr).Namespace(meta.Namespace).Get(ctx, meta.Name, metav1.GetOptions{})
+	if err != nil {
+		return metav1.OwnerReference{}, fmt.Errorf("get %s: %w", gvr.Resource, err)
+	}
+	return metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       app.WorkloadKind(),
+		Name:       workload.GetName(),
+		UID:        workload.GetUID(),
+	}, nil
+}
@@ -0,0 +1,49 @@
+package k8s
+
+import (
+	"testing"
+
+	"scale-handler/internal/domain"
+)
+
+func TestObjectMeta(t *testing.T) {
+	r := &Renderer{defaultNamespace: "default"}
+	schedule := &domain.Schedule{
+		ID:          "web",
+		Generation:  3,
+		Labels:      map[string]string{"team": "payments", "app.kubernetes.io/managed-by": "me"},
+		Annotations: map[string]string{"example.com/owner": "payments", revisionAnnotation: "0"},
+	}
+
+	meta := r.objectMeta(schedule)
+	if meta.Name != "web" || meta.Namespace != "default" {
+		t.Errorf("object = %s/%s, want default/web", meta.Namespace, meta.Name)
+	}
+	for key, want := range map[string]string{
+		"team":                         "payments",
+		"app.kubernetes.io/name":       "web",
+		"app.kubernetes.io/managed-by": managedBy,
+	} {
+		if got := meta.Labels[key]; got != want {
+			t.Errorf("label %s = %q, want %q", key, got, want)
+		}
+	}
+	for key, want := range map[string]string{
+		"example.com/owner":  "payments",
+		scheduleIDAnnotation: "web",
+		revisionAnnotation:   "3",
+	} {
+		if got := meta.Annotations[key]; got != want {
+			t.Errorf("annotation %s = %q, want %q", key, got, want)
+		}
+	}
+
+	// Метки пода дополняются селектором, а исходные метки объекта не меняются
+	labels := podLabels(meta)
+	if labels["app"] != "web" || labels["team"] != "payments" {
+		t.Errorf("pod labels = %v", labels)
+	}
+	if _, ok := meta.Labels["app"]; ok {
+		t.Error("podLabels() modified object labels")
+	}
+}
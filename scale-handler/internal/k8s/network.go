@@ -0,0 +1,210 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"scale-handler/internal/domain"
+)
+
+func ingressGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "networking.k8s.io",
+		Version:  "v1",
+		Resource: "ingresses",
+	}
+}
+
+// applyNetwork применяет Service и Ingress из Application. Если их убрали
+// из Application, созданные ранее объекты удаляются.
+func (r *Reconciler) applyNetwork(ctx context.Context, meta metav1.ObjectMeta, app *domain.Application) error {
+	ns, name := meta.Namespace, meta.Name
+	if app.Service != nil {
+		obj, err := toApplyObject(r.buildService(meta, app))
+		if err != nil {
+			return err
+		}
+		if _, err := r.apply(ctx, serviceGVR(), obj); err != nil {
+			return fmt.Errorf("apply service: %w", err)
+		}
+	} else if _, err := r.deleteOwned(ctx, serviceGVR(), ns, serviceName(name)); err != nil {
+		return fmt.Errorf("delete service: %w", err)
+	}
+
+	if app.Ingress != nil {
+		obj, err := toApplyObject(r.buildIngress(meta, app))
+		if err != nil {
+			return err
+		}
+		if _, err := r.apply(ctx, ingressGVR(), obj); err != nil {
+			return fmt.Errorf("apply ingress: %w", err)
+		}
+	} else if _, err := r.deleteOwned(ctx, ingressGVR(), ns, name); err != nil {
+		return fmt.Errorf("delete ingress: %w", err)
+	}
+	return nil
+}
+
+// deleteNetwork удаляет Service и Ingress, созданные из Application
+func (r *Reconciler) deleteNetwork(ctx context.Context, ns, name string) {
+	if _, err := r.deleteOwned(ctx, ingressGVR(), ns, name); err != nil {
+		r.logger.Error("Failed to delete ingresses", "id", name, "error", err)
+	}
+	if _, err := r.deleteOwned(ctx, serviceGVR(), ns, serviceName(name)); err != nil {
+		r.logger.Error("Failed to delete services", "id", name, "error", err)
+	}
+}
+
+// deleteOwned удаляет объект, только если им управляет scale-handler, чтобы
+// не задеть одноимённый объект, созданный кем-то ещё
+func (r *Reconciler) deleteOwned(ctx context.Context, gvr schema.GroupVersionResource, ns, name string) (bool, error) {
+	client := r.dynamic.Resource(gvr).Namespace(ns)
+	obj, err := client.Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !managedByUs(obj) {
+		return false, nil
+	}
+
+	if err := client.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *Renderer) buildService(meta metav1.ObjectMeta, app *domain.Application) *corev1.Service {
+	ports := containerServicePorts(app)
+	if len(app.Service.Ports) > 0 {
+		ports = make([]corev1.ServicePort, len(app.Service.Ports))
+		for i, p := range app.Service.Ports {
+			protocol := toProtocol(p.Protocol)
+			targetPort := p.TargetPort
+			if targetPort == 0 {
+				targetPort = p.Port
+			}
+			portName := p.Name
+			if portName == "" {
+				portName = fmt.Sprintf("%s-%d", strings.ToLower(string(protocol)), p.Port)
+			}
+			ports[i] = corev1.ServicePort{
+				Name:       portName,
+				Port:       p.Port,
+				TargetPort: intstr.FromInt32(targetPort),
+				Protocol:   protocol,
+			}
+		}
+	}
+
+	serviceType := corev1.ServiceTypeClusterIP
+	if app.Service.Type != "" {
+		serviceType = corev1.ServiceType(app.Service.Type)
+	}
+
+	name := meta.Name
+	meta.Name = serviceName(name)
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
+		ObjectMeta: meta,
+		Spec: corev1.ServiceSpec{
+			Type:     serviceType,
+			Selector: map[string]string{"app": name},
+			Ports:    ports,
+		},
+	}
+}
+
+func (r *Renderer) buildIngress(meta metav1.ObjectMeta, app *domain.Application) *networkingv1.Ingress {
+	spec := app.Ingress
+
+	path := spec.Path
+	if path == "" {
+		path = "/"
+	}
+	pathType := networkingv1.PathTypePrefix
+	if spec.PathType != "" {
+		pathType = networkingv1.PathType(spec.PathType)
+	}
+	port := spec.ServicePort
+	if port == 0 {
+		if ports := r.buildService(meta, app).Spec.Ports; len(ports) > 0 {
+			port = ports[0].Port
+		}
+	}
+
+	ingress := &networkingv1.Ingress{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "networking.k8s.io/v1",
+			Kind:       "Ingress",
+		},
+		ObjectMeta: meta,
+		Spec: networkingv1.IngressSpec{
+			Rules: []networkingv1.IngressRule{{
+				Host: spec.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     path,
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{
+									Name: serviceName(meta.Name),
+									Port: networkingv1.ServiceBackendPort{Number: port},
+								},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+	if spec.ClassName != "" {
+		ingress.Spec.IngressClassName = &spec.ClassName
+	}
+	if spec.TLSSecretName != "" {
+		ingress.Spec.TLS = []networkingv1.IngressTLS{{
+			Hosts:      []string{spec.Host},
+			SecretName: spec.TLSSecretName,
+		}}
+	}
+	return ingress
+}
+
+// containerServicePorts публикует все порты контейнеров под их же номерами
+func containerServicePorts(app *domain.Application) []corev1.ServicePort {
+	var ports []corev1.ServicePort
+	for _, c := range app.Containers {
+		for _, p := range c.Ports {
+			protocol := toProtocol(p.Protocol)
+			ports = append(ports, corev1.ServicePort{
+				Name:       fmt.Sprintf("%s-%d", strings.ToLower(string(protocol)), p.ContainerPort),
+				Port:       int32(p.ContainerPort),
+				TargetPort: intstr.FromInt(p.ContainerPort),
+				Protocol:   protocol,
+			})
+		}
+	}
+	return ports
+}
+
+func toProtocol(protocol string) corev1.Protocol {
+	if strings.ToUpper(protocol) == "UDP" {
+		return corev1.ProtocolUDP
+	}
+	return corev1.ProtocolTCP
+}
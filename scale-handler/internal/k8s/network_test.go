@@ -0,0 +1,35 @@
+package k8s
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"scale-handler/internal/domain"
+)
+
+func TestServiceName(t *testing.T) {
+	r := &Renderer{}
+	id := "7c0e5a6b-1f2d-4e3c-9b8a-0d1e2f3a4b5c"
+	app := &domain.Application{
+		Containers: []domain.Container{{Name: "web", Image: "nginx", Ports: []domain.ContainerPort{{ContainerPort: 8080}}}},
+		Service:    &domain.ServiceSpec{},
+		Ingress:    &domain.IngressSpec{Host: "web.example.com"},
+	}
+
+	meta := r.objectMeta(&domain.Schedule{ID: id, Namespace: "team-a"})
+
+	service := r.buildService(meta, app)
+	if errs := validation.IsDNS1035Label(service.Name); len(errs) > 0 {
+		t.Errorf("Service name %q: %v", service.Name, errs)
+	}
+	if got := service.Spec.Selector["app"]; got != id {
+		t.Errorf("Service selector app = %q, want %q", got, id)
+	}
+
+	ingress := r.buildIngress(meta, app)
+	backend := ingress.Spec.Rules[0].HTTP.Paths[0].Backend.Service
+	if backend.Name != service.Name || backend.Port.Number != 8080 {
+		t.Errorf("Ingress backend = %s:%d, want %s:8080", backend.Name, backend.Port.Number, service.Name)
+	}
+}
@@ -0,0 +1,64 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"scale-handler/internal/domain"
+)
+
+// pausedReplicasAnnotation останавливает масштабирование KEDA: workload
+// приводится к указанному числу реплик и остаётся на нём
+const pausedReplicasAnnotation = "autoscaling.keda.sh/paused-replicas"
+
+// withPause добавляет в метаданные ScaledObject аннотацию паузы. Когда паузу
+// снимают, очередной apply убирает аннотацию.
+func withPause(meta metav1.ObjectMeta, pause *domain.PauseState) metav1.ObjectMeta {
+	if pause == nil {
+		return meta
+	}
+	meta.Annotations = maps.Clone(meta.Annotations)
+	if meta.Annotations == nil {
+		meta.Annotations = map[string]string{}
+	}
+	meta.Annotations[pausedReplicasAnnotation] = strconv.Itoa(int(pause.Replicas))
+	return meta
+}
+
+// CurrentReplicas читает текущее число реплик workload расписания через
+// подресурс /scale
+func (r *Reconciler) CurrentReplicas(ctx context.Context, schedule *domain.Schedule) (int32, error) {
+	ns, name := r.namespace(schedule), schedule.ID
+	gvr := deploymentGVR()
+	switch {
+	case schedule.Target != nil:
+		var err error
+		if gvr, err = r.resolveTarget(schedule.Target); err != nil {
+			return 0, err
+		}
+		name = schedule.Target.Name
+	case schedule.Application == nil || len(schedule.Application.Containers) == 0:
+		return 0, fmt.Errorf("schedule has no workload: %w", domain.ErrInvalidArgument)
+	case schedule.Application.WorkloadKind() == domain.KindStatefulSet:
+		gvr = statefulSetGVR()
+	}
+
+	scale, err := r.dynamic.Resource(gvr).Namespace(ns).Get(ctx, name, metav1.GetOptions{}, "scale")
+	if errors.IsNotFound(err) {
+		return 0, fmt.Errorf("workload %s/%s not found, replicas must be given explicitly: %w", ns, name, domain.ErrInvalidArgument)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("get %s scale: %w", gvr.Resource, err)
+	}
+	replicas, _, err := unstructured.NestedInt64(scale.Object, "spec", "replicas")
+	if err != nil {
+		return 0, fmt.Errorf("read %s scale: %w", gvr.Resource, err)
+	}
+	return int32(replicas), nil
+}
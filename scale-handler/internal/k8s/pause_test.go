@@ -0,0 +1,29 @@
+package k8s
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"scale-handler/internal/domain"
+)
+
+func TestWithPause(t *testing.T) {
+	meta := metav1.ObjectMeta{Name: "web", Annotations: map[string]string{scheduleIDAnnotation: "web"}}
+
+	if got := withPause(meta, nil); got.Annotations[pausedReplicasAnnotation] != "" {
+		t.Errorf("annotations without pause = %v", got.Annotations)
+	}
+
+	paused := withPause(meta, &domain.PauseState{Replicas: 2})
+	if got := paused.Annotations[pausedReplicasAnnotation]; got != "2" {
+		t.Errorf("%s = %q, want 2", pausedReplicasAnnotation, got)
+	}
+	if paused.Annotations[scheduleIDAnnotation] != "web" {
+		t.Errorf("annotations = %v, want schedule id kept", paused.Annotations)
+	}
+	// Метаданные остальных объектов расписания не должны получить аннотацию
+	if _, ok := meta.Annotations[pausedReplicasAnnotation]; ok {
+		t.Error("withPause() modified source annotations")
+	}
+}
@@ -0,0 +1,135 @@
+package k8s
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"scale-handler/internal/domain"
+)
+
+// applyPlacement переносит настройки размещения из Application в PodSpec.
+// Селекторы подов по умолчанию выбирают поды самого приложения, так что
+// типичное «не больше одного пода на узел» не требует явных меток.
+func applyPlacement(spec *corev1.PodSpec, name string, app *domain.Application) {
+	spec.NodeSelector = app.NodeSelector
+	spec.PriorityClassName = app.PriorityClassName
+
+	for _, t := range app.Tolerations {
+		spec.Tolerations = append(spec.Tolerations, corev1.Toleration{
+			Key:               t.Key,
+			Operator:          corev1.TolerationOperator(t.Operator),
+			Value:             t.Value,
+			Effect:            corev1.TaintEffect(t.Effect),
+			TolerationSeconds: t.TolerationSeconds,
+		})
+	}
+
+	for _, c := range app.TopologySpreadConstraints {
+		whenUnsatisfiable := corev1.DoNotSchedule
+		if c.WhenUnsatisfiable != "" {
+			whenUnsatisfiable = corev1.UnsatisfiableConstraintAction(c.WhenUnsatisfiable)
+		}
+		constraint := corev1.TopologySpreadConstraint{
+			MaxSkew:           c.MaxSkew,
+			TopologyKey:       c.TopologyKey,
+			WhenUnsatisfiable: whenUnsatisfiable,
+			LabelSelector:     labelSelectorToK8s(c.LabelSelector, name),
+		}
+		if c.MinDomains > 0 {
+			constraint.MinDomains = &c.MinDomains
+		}
+		spec.TopologySpreadConstraints = append(spec.TopologySpreadConstraints, constraint)
+	}
+
+	if a := app.Affinity; a != nil {
+		spec.Affinity = &corev1.Affinity{
+			NodeAffinity: nodeAffinityToK8s(a.NodeAffinity),
+		}
+		if a.PodAffinity != nil {
+			required, preferred := podAffinityTermsToK8s(a.PodAffinity, name)
+			spec.Affinity.PodAffinity = &corev1.PodAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution:  required,
+				PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+			}
+		}
+		if a.PodAntiAffinity != nil {
+			required, preferred := podAffinityTermsToK8s(a.PodAntiAffinity, name)
+			spec.Affinity.PodAntiAffinity = &corev1.PodAntiAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution:  required,
+				PreferredDuringSchedulingIgnoredDuringExecution: preferred,
+			}
+		}
+	}
+}
+
+func nodeAffinityToK8s(a *domain.NodeAffinity) *corev1.NodeAffinity {
+	if a == nil {
+		return nil
+	}
+	result := &corev1.NodeAffinity{}
+	if len(a.Required) > 0 {
+		result.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+		for _, term := range a.Required {
+			result.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms = append(
+				result.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms, nodeSelectorTermToK8s(term))
+		}
+	}
+	for _, p := range a.Preferred {
+		result.PreferredDuringSchedulingIgnoredDuringExecution = append(result.PreferredDuringSchedulingIgnoredDuringExecution, corev1.PreferredSchedulingTerm{
+			Weight:     p.Weight,
+			Preference: nodeSelectorTermToK8s(p.Preference),
+		})
+	}
+	return result
+}
+
+func nodeSelectorTermToK8s(term domain.NodeSelectorTerm) corev1.NodeSelectorTerm {
+	var result corev1.NodeSelectorTerm
+	for _, r := range term.MatchExpressions {
+		result.MatchExpressions = append(result.MatchExpressions, corev1.NodeSelectorRequirement{
+			Key:      r.Key,
+			Operator: corev1.NodeSelectorOperator(r.Operator),
+			Values:   r.Values,
+		})
+	}
+	return result
+}
+
+func podAffinityTermsToK8s(a *domain.PodAffinity, name string) ([]corev1.PodAffinityTerm, []corev1.WeightedPodAffinityTerm) {
+	var required []corev1.PodAffinityTerm
+	for _, term := range a.Required {
+		required = append(required, podAffinityTermToK8s(term, name))
+	}
+	var preferred []corev1.WeightedPodAffinityTerm
+	for _, p := range a.Preferred {
+		preferred = append(preferred, corev1.WeightedPodAffinityTerm{
+			Weight:          p.Weight,
+			PodAffinityTerm: podAffinityTermToK8s(p.Term, name),
+		})
+	}
+	return required, preferred
+}
+
+func podAffinityTermToK8s(term domain.PodAffinityTerm, name string) corev1.PodAffinityTerm {
+	return corev1.PodAffinityTerm{
+		LabelSelector: labelSelectorToK8s(term.LabelSelector, name),
+		TopologyKey:   term.TopologyKey,
+		Namespaces:    term.Namespaces,
+	}
+}
+
+// labelSelectorToK8s без селектора выбирает поды приложения name
+func labelSelectorToK8s(s *domain.LabelSelector, name string) *metav1.LabelSelector {
+	if s == nil {
+		return &metav1.LabelSelector{MatchLabels: map[string]string{"app": name}}
+	}
+	result := &metav1.LabelSelector{MatchLabels: s.MatchLabels}
+	for _, r := range s.MatchExpressions {
+		result.MatchExpressions = append(result.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      r.Key,
+			Operator: metav1.LabelSelectorOperator(r.Operator),
+			Values:   r.Values,
+		})
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package k8s
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"scale-handler/internal/domain"
+)
+
+func TestApplyPlacement(t *testing.T) {
+	app := &domain.Application{
+		NodeSelector:      map[string]string{"pool": "spot"},
+		PriorityClassName: "batch",
+		Tolerations:       []domain.Toleration{{Key: "spot", Operator: "Exists", Effect: "NoSchedule"}},
+		TopologySpreadConstraints: []domain.TopologySpreadConstraint{
+			{MaxSkew: 1, TopologyKey: "topology.kubernetes.io/zone"},
+		},
+		Affinity: &domain.Affinity{
+			PodAntiAffinity: &domain.PodAffinity{
+				Required: []domain.PodAffinityTerm{{TopologyKey: "kubernetes.io/hostname"}},
+			},
+		},
+	}
+
+	var spec corev1.PodSpec
+	applyPlacement(&spec, "web", app)
+
+	if spec.NodeSelector["pool"] != "spot" || spec.PriorityClassName != "batch" {
+		t.Errorf("nodeSelector = %v, priorityClassName = %q", spec.NodeSelector, spec.PriorityClassName)
+	}
+	if len(spec.Tolerations) != 1 || spec.Tolerations[0].Operator != corev1.TolerationOpExists {
+		t.Errorf("tolerations = %+v", spec.Tolerations)
+	}
+
+	if len(spec.TopologySpreadConstraints) != 1 {
+		t.Fatalf("topologySpreadConstraints = %+v", spec.TopologySpreadConstraints)
+	}
+	constraint := spec.TopologySpreadConstraints[0]
+	if constraint.WhenUnsatisfiable != corev1.DoNotSchedule {
+		t.Errorf("whenUnsatisfiable = %s, want DoNotSchedule", constraint.WhenUnsatisfiable)
+	}
+	if got := constraint.LabelSelector.MatchLabels["app"]; got != "web" {
+		t.Errorf("topology spread selector app = %q, want web", got)
+	}
+
+	if spec.Affinity == nil || spec.Affinity.PodAntiAffinity == nil {
+		t.Fatalf("affinity = %+v", spec.Affinity)
+	}
+	terms := spec.Affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(terms) != 1 || terms[0].LabelSelector.MatchLabels["app"] != "web" {
+		t.Errorf("pod anti-affinity terms = %+v", terms)
+	}
+	if spec.Affinity.NodeAffinity != nil || spec.Affinity.PodAffinity != nil {
+		t.Errorf("unexpected affinity: %+v", spec.Affinity)
+	}
+}
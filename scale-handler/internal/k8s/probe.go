@@ -0,0 +1,51 @@
+package k8s
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"scale-handler/internal/domain"
+)
+
+// probeToK8s строит проверку контейнера; нулевые параметры оставляются
+// на значения Kubernetes по умолчанию
+func probeToK8s(p *domain.Probe) *corev1.Probe {
+	if p == nil {
+		return nil
+	}
+	probe := &corev1.Probe{
+		InitialDelaySeconds: p.InitialDelaySeconds,
+		PeriodSeconds:       p.PeriodSeconds,
+		TimeoutSeconds:      p.TimeoutSeconds,
+		FailureThreshold:    p.FailureThreshold,
+		SuccessThreshold:    p.SuccessThreshold,
+	}
+	switch {
+	case p.HTTPGet != nil:
+		probe.HTTPGet = httpGetToK8s(p.HTTPGet)
+	case p.TCPSocket != nil:
+		probe.TCPSocket = &corev1.TCPSocketAction{Port: intstr.FromInt32(p.TCPSocket.Port)}
+	case p.Exec != nil:
+		probe.Exec = &corev1.ExecAction{Command: p.Exec.Command}
+	case p.GRPC != nil:
+		probe.GRPC = &corev1.GRPCAction{Port: p.GRPC.Port}
+		if p.GRPC.Service != "" {
+			probe.GRPC.Service = &p.GRPC.Service
+		}
+	default:
+		return nil
+	}
+	return probe
+}
+
+func httpGetToK8s(h *domain.HTTPGetAction) *corev1.HTTPGetAction {
+	action := &corev1.HTTPGetAction{
+		Path:   h.Path,
+		Port:   intstr.FromInt32(h.Port),
+		Scheme: corev1.URIScheme(h.Scheme),
+	}
+	for _, header := range h.HTTPHeaders {
+		action.HTTPHeaders = append(action.HTTPHeaders, corev1.HTTPHeader{Name: header.Name, Value: header.Value})
+	}
+	return action
+}
@@ -0,0 +1,75 @@
+package k8s
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"scale-handler/internal/domain"
+)
+
+func TestProbeToK8s(t *testing.T) {
+	tests := []struct {
+		name  string
+		probe *domain.Probe
+		check func(t *testing.T, p *corev1.Probe)
+	}{
+		{
+			name: "no probe",
+			check: func(t *testing.T, p *corev1.Probe) {
+				if p != nil {
+					t.Errorf("probe = %+v, want nil", p)
+				}
+			},
+		},
+		{
+			name:  "no handler",
+			probe: &domain.Probe{PeriodSeconds: 10},
+			check: func(t *testing.T, p *corev1.Probe) {
+				if p != nil {
+					t.Errorf("probe = %+v, want nil", p)
+				}
+			},
+		},
+		{
+			name: "http with headers",
+			probe: &domain.Probe{
+				HTTPGet:          &domain.HTTPGetAction{Path: "/healthz", Port: 8080, Scheme: "HTTPS", HTTPHeaders: []domain.HTTPHeader{{Name: "Host", Value: "web"}}},
+				TimeoutSeconds:   2,
+				FailureThreshold: 5,
+			},
+			check: func(t *testing.T, p *corev1.Probe) {
+				if p.HTTPGet == nil || p.HTTPGet.Port.IntVal != 8080 || p.HTTPGet.Scheme != corev1.URISchemeHTTPS || len(p.HTTPGet.HTTPHeaders) != 1 {
+					t.Errorf("httpGet = %+v", p.HTTPGet)
+				}
+				if p.TimeoutSeconds != 2 || p.FailureThreshold != 5 {
+					t.Errorf("timeoutSeconds = %d, failureThreshold = %d", p.TimeoutSeconds, p.FailureThreshold)
+				}
+			},
+		},
+		{
+			name:  "tcp",
+			probe: &domain.Probe{TCPSocket: &domain.TCPSocketAction{Port: 5432}},
+			check: func(t *testing.T, p *corev1.Probe) {
+				if p.TCPSocket == nil || p.TCPSocket.Port.IntVal != 5432 || p.HTTPGet != nil {
+					t.Errorf("probe = %+v", p.ProbeHandler)
+				}
+			},
+		},
+		{
+			name:  "grpc without service",
+			probe: &domain.Probe{GRPC: &domain.GRPCAction{Port: 9090}},
+			check: func(t *testing.T, p *corev1.Probe) {
+				if p.GRPC == nil || p.GRPC.Port != 9090 || p.GRPC.Service != nil {
+					t.Errorf("grpc = %+v", p.GRPC)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.check(t, probeToK8s(tt.probe))
+		})
+	}
+}
@@ -6,44 +6,45 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
+	"time"
 
-	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
+	"scale-handler/internal/config"
 	"scale-handler/internal/domain"
+	"scale-handler/internal/reconciler"
 )
 
 const (
-	namespace        = "default"
-	timezone         = "Europe/Moscow"
 	kedaAPIVersion   = "keda.sh/v1alpha1"
 	scaledObjectKind = "ScaledObject"
 )
 
-var weekdayToCron = map[string]string{
-	"monday": "1", "tuesday": "2", "wednesday": "3", "thursday": "4",
-	"friday": "5", "saturday": "6", "sunday": "0",
-}
+var (
+	_ reconciler.Reconciler       = (*Reconciler)(nil)
+	_ reconciler.GarbageCollector = (*Reconciler)(nil)
+)
 
+// Reconciler применяет объекты, которые строит Renderer, в кластер
 type Reconciler struct {
-	clientset *kubernetes.Clientset
-	dynamic   dynamic.Interface
-	logger    *slog.Logger
+	*Renderer
+	clientset        *kubernetes.Clientset
+	dynamic          dynamic.Interface
+	createNamespaces bool
+	namespaceLabels  map[string]string
 }
 
-func NewReconciler(kubeconfigPath string, logger *slog.Logger) (*Reconciler, error) {
+func NewReconciler(kubeconfigPath string, cfg config.K8sConfig, logger *slog.Logger) (*Reconciler, error) {
 	// Определяем путь к kubeconfig
 	if kubeconfigPath == "" {
 		kubeconfigPath = os.Getenv("KUBECONFIG")
@@ -100,110 +101,135 @@ func NewReconciler(kubeconfigPath string, logger *slog.Logger) (*Reconciler, err
 		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
 	}
 
+	renderer, err := NewRenderer(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Reconciler{
-		clientset: clientset,
-		dynamic:   dyn,
-		logger:    logger,
+		Renderer:         renderer,
+		clientset:        clientset,
+		dynamic:          dyn,
+		createNamespaces: cfg.CreateNamespaces,
+		namespaceLabels:  cfg.NamespaceLabels,
 	}, nil
 }
 
 func (r *Reconciler) CreateResources(ctx context.Context, schedule *domain.Schedule) error {
+	if schedule.Target != nil {
+		return r.applyTarget(ctx, schedule)
+	}
 	if schedule.Application == nil || len(schedule.Application.Containers) == 0 {
 		r.logger.Warn("No application containers, skipping K8s creation", "id", schedule.ID)
 		return nil
 	}
 
-	name := schedule.ID
-	if err := r.createDeployment(ctx, name, schedule.Application); err != nil {
-		return err
-	}
-	return r.createScaledObject(ctx, name, &schedule.Rules)
+	return r.applyApplication(ctx, schedule)
 }
 
 func (r *Reconciler) UpdateResources(ctx context.Context, schedule *domain.Schedule) error {
+	if schedule.Target != nil {
+		return r.applyTarget(ctx, schedule)
+	}
 	if schedule.Application == nil || len(schedule.Application.Containers) == 0 {
-		return r.DeleteResources(ctx, schedule.ID)
+		return r.DeleteResources(ctx, schedule)
 	}
 
-	name := schedule.ID
-	if err := r.updateDeployment(ctx, name, schedule.Application); err != nil {
-		return err
-	}
-	return r.updateScaledObject(ctx, name, &schedule.Rules)
+	return r.applyApplication(ctx, schedule)
 }
 
-func (r *Reconciler) DeleteResources(ctx context.Context, scheduleID string) error {
-	client := r.dynamic.Resource(scaledObjectGVR()).Namespace(namespace)
-	if err := client.Delete(ctx, scheduleID, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
-		r.logger.Error("Failed to delete ScaledObject", "id", scheduleID, "error", err)
+func (r *Reconciler) DeleteResources(ctx context.Context, schedule *domain.Schedule) error {
+	name, ns := schedule.ID, r.namespace(schedule)
+	client := r.dynamic.Resource(scaledObjectGVR()).Namespace(ns)
+	if err := client.Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+		if schedule.Target != nil {
+			return fmt.Errorf("delete ScaledObject: %w", err)
+		}
+		r.logger.Error("Failed to delete ScaledObject", "id", name, "error", err)
+	}
+
+	// Существующий workload принадлежит не нам и не удаляется
+	if schedule.Target != nil {
+		return nil
 	}
 
-	return r.clientset.AppsV1().Deployments(namespace).Delete(ctx, scheduleID, metav1.DeleteOptions{})
+	return r.deleteWorkload(ctx, ns, name, schedule.Application)
 }
 
-func scaledObjectGVR() schema.GroupVersionResource {
-	return schema.GroupVersionResource{
-		Group:    "keda.sh",
-		Version:  "v1alpha1",
-		Resource: "scaledobjects",
+// applyApplication создаёт или обновляет workload из Application вместе с
+// Service, Ingress и ScaledObject
+func (r *Reconciler) applyApplication(ctx context.Context, schedule *domain.Schedule) error {
+	meta, app := r.objectMeta(schedule), schedule.Application
+	if err := r.ensureNamespace(ctx, meta.Namespace); err != nil {
+		return err
+	}
+	if err := r.applyWorkload(ctx, meta, app, schedule.Rules.DefaultReplicas); err != nil {
+		return err
+	}
+	if err := r.applyNetwork(ctx, meta, app); err != nil {
+		return err
 	}
-}
 
-func (r *Reconciler) createDeployment(ctx context.Context, name string, app *domain.Application) error {
-	deployment := r.buildDeployment(name, app)
-	_, err := r.clientset.AppsV1().Deployments(namespace).Create(ctx, deployment, metav1.CreateOptions{})
+	owner, err := r.workloadOwner(ctx, meta, app)
 	if err != nil {
-		return fmt.Errorf("create deployment: %w", err)
+		return err
 	}
-	r.logger.Info("Created Deployment", "name", name, "namespace", namespace)
-	return nil
+	scaledObjectMeta := withPause(meta, schedule.Pause)
+	scaledObjectMeta.OwnerReferences = []metav1.OwnerReference{owner}
+	return r.applyScaledObject(ctx, scaledObjectMeta, schedule)
 }
 
-func (r *Reconciler) updateDeployment(ctx context.Context, name string, app *domain.Application) error {
-	deployment := r.buildDeployment(name, app)
-	_, err := r.clientset.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
-	if err != nil {
-		if errors.IsNotFound(err) {
-			return r.createDeployment(ctx, name, app)
-		}
-		return fmt.Errorf("update deployment: %w", err)
+func (r *Renderer) namespace(schedule *domain.Schedule) string {
+	if schedule.Namespace == "" {
+		return r.defaultNamespace
 	}
-	r.logger.Info("Updated Deployment", "name", name)
-	return nil
+	return schedule.Namespace
 }
 
-func (r *Reconciler) buildDeployment(name string, app *domain.Application) *appsv1.Deployment {
-	containers := make([]corev1.Container, len(app.Containers))
-	for i, c := range app.Containers {
-		containers[i] = r.containerToK8s(c)
+// ensureNamespace проверяет что namespace существует и, если разрешено
+// настройками, создаёт его с заданными метками
+func (r *Reconciler) ensureNamespace(ctx context.Context, ns string) error {
+	_, err := r.clientset.CoreV1().Namespaces().Get(ctx, ns, metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return fmt.Errorf("get namespace: %w", err)
+	}
+	if !r.createNamespaces {
+		return fmt.Errorf("namespace %s does not exist", ns)
 	}
 
-	return &appsv1.Deployment{
+	nsObj := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-		},
-		Spec: appsv1.DeploymentSpec{
-			Replicas: int32Ptr(0),
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{"app": name},
-			},
-			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{
-					Labels: map[string]string{"app": name},
-				},
-				Spec: corev1.PodSpec{
-					Containers: containers,
-				},
-			},
+			Name:   ns,
+			Labels: r.namespaceLabels,
 		},
 	}
+	if _, err := r.clientset.CoreV1().Namespaces().Create(ctx, nsObj, metav1.CreateOptions{}); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("create namespace: %w", err)
+	}
+	r.logger.Info("Created Namespace", "name", ns)
+	return nil
 }
 
-func (r *Reconciler) containerToK8s(c domain.Container) corev1.Container {
+func scaledObjectGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "keda.sh",
+		Version:  "v1alpha1",
+		Resource: "scaledobjects",
+	}
+}
+
+func (r *Renderer) containerToK8s(c domain.Container) (corev1.Container, error) {
 	cont := corev1.Container{
-		Name:  c.Name,
-		Image: c.Image,
+		Name:            c.Name,
+		Image:           c.Image,
+		Command:         c.Command,
+		Args:            c.Args,
+		ImagePullPolicy: corev1.PullPolicy(c.ImagePullPolicy),
+		SecurityContext: securityContextToK8s(c.SecurityContext),
+		Lifecycle:       lifecycleToK8s(c.Lifecycle),
 	}
 	if len(c.Ports) > 0 {
 		cont.Ports = make([]corev1.ContainerPort, len(c.Ports))
@@ -218,172 +244,104 @@ func (r *Reconciler) containerToK8s(c domain.Container) corev1.Container {
 			}
 		}
 	}
-	if len(c.Env) > 0 {
-		cont.Env = make([]corev1.EnvVar, len(c.Env))
-		for i, e := range c.Env {
-			cont.Env[i] = corev1.EnvVar{Name: e.Name, Value: e.Value}
-		}
-	}
+	cont.Env = envToK8s(c.Env)
+	cont.EnvFrom = envFromToK8s(c.EnvFrom)
+	cont.VolumeMounts = volumeMountsToK8s(c.VolumeMounts)
 	if c.Resources != nil {
-		cont.Resources = corev1.ResourceRequirements{}
-		if c.Resources.Requests != nil {
-			cont.Resources.Requests = corev1.ResourceList{}
-			if c.Resources.Requests.Memory != "" {
-				cont.Resources.Requests[corev1.ResourceMemory] = resource.MustParse(c.Resources.Requests.Memory)
-			}
-			if c.Resources.Requests.CPU != "" {
-				cont.Resources.Requests[corev1.ResourceCPU] = resource.MustParse(c.Resources.Requests.CPU)
-			}
+		var err error
+		if cont.Resources.Requests, err = resourceList(c.Resources.Requests); err != nil {
+			return cont, fmt.Errorf("requests: %w", err)
 		}
-		if c.Resources.Limits != nil {
-			cont.Resources.Limits = corev1.ResourceList{}
-			if c.Resources.Limits.Memory != "" {
-				cont.Resources.Limits[corev1.ResourceMemory] = resource.MustParse(c.Resources.Limits.Memory)
-			}
-			if c.Resources.Limits.CPU != "" {
-				cont.Resources.Limits[corev1.ResourceCPU] = resource.MustParse(c.Resources.Limits.CPU)
-			}
+		if cont.Resources.Limits, err = resourceList(c.Resources.Limits); err != nil {
+			return cont, fmt.Errorf("limits: %w", err)
 		}
 	}
-	if c.LivenessProbe != nil && c.LivenessProbe.HTTPGet != nil {
-		cont.LivenessProbe = &corev1.Probe{
-			ProbeHandler: corev1.ProbeHandler{
-				HTTPGet: &corev1.HTTPGetAction{
-					Path: c.LivenessProbe.HTTPGet.Path,
-					Port: intstr.FromInt32(c.LivenessProbe.HTTPGet.Port),
-				},
-			},
-			InitialDelaySeconds: c.LivenessProbe.InitialDelaySeconds,
-			PeriodSeconds:       c.LivenessProbe.PeriodSeconds,
-		}
+	cont.LivenessProbe = probeToK8s(c.LivenessProbe)
+	cont.ReadinessProbe = probeToK8s(c.ReadinessProbe)
+	cont.StartupProbe = probeToK8s(c.StartupProbe)
+	return cont, nil
+}
+
+// resourceList переводит cpu и memory в ResourceList. Некорректная quantity
+// возвращается ошибкой: сохранённое расписание не должно ронять resync.
+func resourceList(q *domain.ResourceQuantity) (corev1.ResourceList, error) {
+	if q == nil {
+		return nil, nil
 	}
-	if c.ReadinessProbe != nil && c.ReadinessProbe.HTTPGet != nil {
-		cont.ReadinessProbe = &corev1.Probe{
-			ProbeHandler: corev1.ProbeHandler{
-				HTTPGet: &corev1.HTTPGetAction{
-					Path: c.ReadinessProbe.HTTPGet.Path,
-					Port: intstr.FromInt32(c.ReadinessProbe.HTTPGet.Port),
-				},
-			},
-			InitialDelaySeconds: c.ReadinessProbe.InitialDelaySeconds,
-			PeriodSeconds:       c.ReadinessProbe.PeriodSeconds,
+	list := corev1.ResourceList{}
+	for name, value := range map[corev1.ResourceName]string{corev1.ResourceCPU: q.CPU, corev1.ResourceMemory: q.Memory} {
+		if value == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %q: %w", name, value, err)
 		}
+		list[name] = quantity
 	}
-	return cont
+	return list, nil
 }
 
-func (r *Reconciler) createScaledObject(ctx context.Context, name string, rules *domain.ScheduleRules) error {
-	obj := r.buildScaledObject(name, rules)
-	client := r.dynamic.Resource(scaledObjectGVR()).Namespace(namespace)
-	_, err := client.Create(ctx, obj, metav1.CreateOptions{})
-	if err != nil {
-		return fmt.Errorf("create ScaledObject: %w", err)
+func (r *Reconciler) applyScaledObject(ctx context.Context, meta metav1.ObjectMeta, schedule *domain.Schedule) error {
+	obj := r.buildScaledObject(meta, schedule)
+	if _, err := r.apply(ctx, scaledObjectGVR(), obj); err != nil {
+		return fmt.Errorf("apply ScaledObject: %w", err)
 	}
-	r.logger.Info("Created ScaledObject", "name", name)
+	r.logger.Info("Applied ScaledObject", "name", meta.Name, "namespace", meta.Namespace)
 	return nil
 }
 
-func (r *Reconciler) updateScaledObject(ctx context.Context, name string, rules *domain.ScheduleRules) error {
-	obj := r.buildScaledObject(name, rules)
-	client := r.dynamic.Resource(scaledObjectGVR()).Namespace(namespace)
-	_, err := client.Update(ctx, obj, metav1.UpdateOptions{})
-	if err != nil {
-		if errors.IsNotFound(err) {
-			return r.createScaledObject(ctx, name, rules)
-		}
-		return fmt.Errorf("update ScaledObject: %w", err)
-	}
-	r.logger.Info("Updated ScaledObject", "name", name)
-	return nil
-}
+// buildScaledObject строит ScaledObject из cron-окон, переопределений и
+// метрических триггеров; метки, аннотации и ссылка на владельца берутся из meta
+func (r *Renderer) buildScaledObject(meta metav1.ObjectMeta, schedule *domain.Schedule) *unstructured.Unstructured {
+	rules := &schedule.Rules
+	loc, now := r.location(rules), time.Now()
+	triggers := buildTriggers(rules, loc, now)
+	triggers = append(triggers, overrideTriggers(schedule.Overrides, loc, now)...)
 
-func (r *Reconciler) buildScaledObject(name string, rules *domain.ScheduleRules) *unstructured.Unstructured {
-	triggers := []map[string]interface{}{}
-
-	for day, ranges := range rules.Weekdays {
-		dow, ok := weekdayToCron[strings.ToLower(day)]
-		if !ok {
-			continue
-		}
-		for _, tr := range ranges {
-			start := timeToCron(tr.From, "*", "*", dow) // weekday: day=*, month=*, dow=1-7
-			end := timeToCron(tr.To, "*", "*", dow)
-			triggers = append(triggers, map[string]interface{}{
-				"type": "cron",
-				"metadata": map[string]interface{}{
-					"timezone":        timezone,
-					"start":           start,
-					"end":             end,
-					"desiredReplicas": strconv.Itoa(int(tr.Replicas)),
-				},
-			})
-		}
+	// KEDA требует хотя бы один триггер. Дежурный cron-триггер остаётся и
+	// при метрических: с одними cpu/memory KEDA не допускает minReplicaCount 0.
+	if len(triggers) == 0 {
+		triggers = append(triggers, cronTrigger(loc, "0 0 * * *", "0 1 * * *", rules.DefaultReplicas))
 	}
+	triggers = append(triggers, metricTriggers(rules.Triggers)...)
 
-	for dateStr, ranges := range rules.Dates {
-		parts := strings.Split(dateStr, "-") // YYYY-MM-DD
-		if len(parts) != 3 {
-			continue
-		}
-		day, month := parts[2], parts[1] // day=01, month=01
-		for _, tr := range ranges {
-			start := timeToCron(tr.From, day, month, "*") // date: day=01, month=01, dow=*
-			end := timeToCron(tr.To, day, month, "*")
-			triggers = append(triggers, map[string]interface{}{
-				"type": "cron",
-				"metadata": map[string]interface{}{
-					"timezone":        timezone,
-					"start":           start,
-					"end":             end,
-					"desiredReplicas": strconv.Itoa(int(tr.Replicas)),
-				},
-			})
-		}
+	spec := map[string]interface{}{
+		"scaleTargetRef": scaleTargetRef(schedule),
+		"triggers":       triggers,
 	}
+	applyScalingOptions(spec, rules)
 
-	if len(triggers) == 0 {
-		triggers = append(triggers, map[string]interface{}{
-			"type": "cron",
-			"metadata": map[string]interface{}{
-				"timezone":        timezone,
-				"start":           "0 0 * * *",
-				"end":             "0 1 * * *",
-				"desiredReplicas": "0",
-			},
-		})
-	}
-
-	return &unstructured.Unstructured{
+	obj := &unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": kedaAPIVersion,
 			"kind":       scaledObjectKind,
 			"metadata": map[string]interface{}{
-				"name":      name,
-				"namespace": namespace,
-			},
-			"spec": map[string]interface{}{
-				"scaleTargetRef": map[string]interface{}{
-					"name": name,
-				},
-				"minReplicaCount": 0,
-				"maxReplicaCount": 100,
-				"cooldownPeriod":  300,
-				"triggers":        triggers,
+				"name":      meta.Name,
+				"namespace": meta.Namespace,
 			},
+			"spec": spec,
 		},
 	}
+	obj.SetLabels(meta.Labels)
+	obj.SetAnnotations(meta.Annotations)
+	obj.SetOwnerReferences(meta.OwnerReferences)
+	return obj
 }
 
-func int32Ptr(i int32) *int32 {
-	return &i
+// location возвращает таймзону расписания или таймзону сервиса по умолчанию
+func (r *Renderer) location(rules *domain.ScheduleRules) *time.Location {
+	if rules.Timezone == "" {
+		return r.defaultTimezone
+	}
+	loc, err := time.LoadLocation(rules.Timezone)
+	if err != nil || !config.IsIANATimezone(rules.Timezone) {
+		r.logger.Warn("Invalid schedule timezone, using default", "timezone", rules.Timezone, "error", err)
+		return r.defaultTimezone
+	}
+	return loc
 }
 
-func timeToCron(timeStr, day, month, dow string) string {
-	parts := strings.Split(timeStr, ":")
-	if len(parts) != 2 {
-		return "0 0 * * *"
-	}
-	// Cron: minute hour day month day-of-week
-	minute, hour := parts[1], parts[0]
-	return fmt.Sprintf("%s %s %s %s %s", minute, hour, day, month, dow)
+func int32Ptr(i int32) *int32 {
+	return &i
 }
@@ -0,0 +1,176 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"scale-handler/internal/config"
+	"scale-handler/internal/domain"
+)
+
+// Renderer строит объекты расписания, не обращаясь к кластеру. Кроме
+// KEDA-бэкенда им пользуются бэкенды, которым нужны те же объекты.
+type Renderer struct {
+	defaultTimezone  *time.Location
+	defaultNamespace string
+	logger           *slog.Logger
+}
+
+func NewRenderer(cfg config.K8sConfig, logger *slog.Logger) (*Renderer, error) {
+	defaultTimezone, err := time.LoadLocation(cfg.DefaultTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load default timezone: %w", err)
+	}
+
+	return &Renderer{
+		defaultTimezone:  defaultTimezone,
+		defaultNamespace: cfg.DefaultNamespace,
+		logger:           logger,
+	}, nil
+}
+
+// desiredObject - объект расписания в том виде, в каком он уходит в apply
+type desiredObject struct {
+	gvr schema.GroupVersionResource
+	obj *unstructured.Unstructured
+}
+
+// desiredObjects строит все объекты расписания, не обращаясь к кластеру.
+// Workload несёт начальное число реплик, как при создании. Ссылка ScaledObject
+// на workload не ставится: UID workload известен только в кластере.
+func (r *Renderer) desiredObjects(schedule *domain.Schedule) ([]desiredObject, error) {
+	meta := r.objectMeta(schedule)
+	var objects []desiredObject
+	add := func(gvr schema.GroupVersionResource, typed runtime.Object) error {
+		obj, err := toApplyObject(typed)
+		if err != nil {
+			return err
+		}
+		objects = append(objects, desiredObject{gvr: gvr, obj: obj})
+		return nil
+	}
+
+	if schedule.Target == nil {
+		app := schedule.Application
+		if app == nil || len(app.Containers) == 0 {
+			return nil, nil
+		}
+		if app.WorkloadKind() == domain.KindStatefulSet {
+			if err := add(serviceGVR(), r.buildHeadlessService(meta, app)); err != nil {
+				return nil, err
+			}
+		}
+		workload, gvr, err := r.buildWorkload(meta, app, schedule.Rules.DefaultReplicas)
+		if err != nil {
+			return nil, err
+		}
+		if err := add(gvr, workload); err != nil {
+			return nil, err
+		}
+		if app.Service != nil {
+			if err := add(serviceGVR(), r.buildService(meta, app)); err != nil {
+				return nil, err
+			}
+		}
+		if app.Ingress != nil {
+			if err := add(ingressGVR(), r.buildIngress(meta, app)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	objects = append(objects, desiredObject{
+		gvr: scaledObjectGVR(),
+		obj: r.buildScaledObject(withPause(meta, schedule.Pause), schedule),
+	})
+	return objects, nil
+}
+
+// Render возвращает объекты расписания, ничего не отправляя в кластер
+func (r *Renderer) Render(schedule *domain.Schedule) ([]domain.Manifest, error) {
+	objects, err := r.desiredObjects(schedule)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]domain.Manifest, len(objects))
+	for i, o := range objects {
+		if result[i], err = toManifest(o.obj); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func toManifest(obj *unstructured.Unstructured) (domain.Manifest, error) {
+	content, err := obj.MarshalJSON()
+	if err != nil {
+		return domain.Manifest{}, fmt.Errorf("marshal %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	return domain.Manifest{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+		Content:    content,
+	}, nil
+}
+
+// DryRun прогоняет объекты расписания через server-side dry-run и возвращает
+// их в том виде, в каком их сохранил бы сервер. Отказ admission или
+// валидации API возвращается как ErrInvalidArgument.
+func (r *Reconciler) DryRun(ctx context.Context, schedule *domain.Schedule) ([]domain.Manifest, error) {
+	objects, err := r.desiredObjects(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	// Объекты в отсутствующем namespace сервер не проверит даже в dry-run
+	ns := r.namespace(schedule)
+	if _, err := r.clientset.CoreV1().Namespaces().Get(ctx, ns, metav1.GetOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, fmt.Errorf("namespace %s does not exist, server-side dry-run is not possible: %w", ns, domain.ErrInvalidArgument)
+		}
+		return nil, fmt.Errorf("get namespace: %w", err)
+	}
+	if schedule.Target != nil {
+		if err := r.checkTarget(ctx, ns, schedule.Target); err != nil {
+			return nil, fmt.Errorf("%v: %w", err, domain.ErrInvalidArgument)
+		}
+	}
+
+	result := make([]domain.Manifest, 0, len(objects))
+	for _, o := range objects {
+		// Существующему workload реплики не передаются, как и при apply
+		if o.gvr == deploymentGVR() || o.gvr == statefulSetGVR() {
+			_, err := r.dynamic.Resource(o.gvr).Namespace(ns).Get(ctx, o.obj.GetName(), metav1.GetOptions{})
+			if err == nil {
+				unstructured.RemoveNestedField(o.obj.Object, "spec", "replicas")
+			} else if !errors.IsNotFound(err) {
+				return nil, fmt.Errorf("get %s: %w", o.gvr.Resource, err)
+			}
+		}
+
+		obj, err := r.applyDryRun(ctx, o.gvr, o.obj)
+		if errors.IsInvalid(err) || errors.IsForbidden(err) || errors.IsBadRequest(err) {
+			return nil, fmt.Errorf("dry-run %s %s: %v: %w", o.obj.GetKind(), o.obj.GetName(), err, domain.ErrInvalidArgument)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("dry-run %s %s: %w", o.obj.GetKind(), o.obj.GetName(), err)
+		}
+		obj.SetManagedFields(nil)
+		manifest, err := toManifest(obj)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, manifest)
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package k8s
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"scale-handler/internal/domain"
+)
+
+func TestRender(t *testing.T) {
+	r := &Renderer{defaultTimezone: time.UTC, defaultNamespace: "default"}
+	schedule := &domain.Schedule{
+		ID:        "7c0e5a6b-1f2d-4e3c-9b8a-0d1e2f3a4b5c",
+		Namespace: "team-a",
+		Rules:     domain.ScheduleRules{DefaultReplicas: 2},
+		Application: &domain.Application{
+			Kind:       domain.KindStatefulSet,
+			Containers: []domain.Container{{Name: "web", Image: "nginx", Ports: []domain.ContainerPort{{ContainerPort: 8080}}}},
+			Service:    &domain.ServiceSpec{},
+			Ingress:    &domain.IngressSpec{Host: "web.example.com"},
+		},
+	}
+
+	objects, err := r.Render(schedule)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	var kinds []string
+	for _, obj := range objects {
+		kinds = append(kinds, obj.Kind)
+		if obj.Namespace != "team-a" {
+			t.Errorf("%s namespace = %q, want team-a", obj.Kind, obj.Namespace)
+		}
+		// UID workload известен только в кластере
+		if bytes.Contains(obj.Content, []byte("ownerReferences")) {
+			t.Errorf("%s has ownerReferences", obj.Kind)
+		}
+	}
+	want := []string{"Service", "StatefulSet", "Service", "Ingress", "ScaledObject"}
+	if !reflect.DeepEqual(kinds, want) {
+		t.Errorf("Render() kinds = %v, want %v", kinds, want)
+	}
+
+	// Для target создаётся только ScaledObject
+	schedule.Application = nil
+	schedule.Target = &domain.TargetRef{Name: "web"}
+	objects, err = r.Render(schedule)
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if len(objects) != 1 || objects[0].Kind != "ScaledObject" {
+		t.Errorf("Render() for target returned %d objects", len(objects))
+	}
+}
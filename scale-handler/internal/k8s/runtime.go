@@ -0,0 +1,73 @@
+package k8s
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"scale-handler/internal/domain"
+)
+
+func securityContextToK8s(sc *domain.SecurityContext) *corev1.SecurityContext {
+	if sc == nil {
+		return nil
+	}
+	result := &corev1.SecurityContext{
+		RunAsUser:                sc.RunAsUser,
+		RunAsGroup:               sc.RunAsGroup,
+		RunAsNonRoot:             sc.RunAsNonRoot,
+		ReadOnlyRootFilesystem:   sc.ReadOnlyRootFilesystem,
+		AllowPrivilegeEscalation: sc.AllowPrivilegeEscalation,
+		Privileged:               sc.Privileged,
+	}
+	if caps := sc.Capabilities; caps != nil {
+		result.Capabilities = &corev1.Capabilities{}
+		for _, c := range caps.Add {
+			result.Capabilities.Add = append(result.Capabilities.Add, corev1.Capability(c))
+		}
+		for _, c := range caps.Drop {
+			result.Capabilities.Drop = append(result.Capabilities.Drop, corev1.Capability(c))
+		}
+	}
+	return result
+}
+
+func podSecurityContextToK8s(sc *domain.PodSecurityContext) *corev1.PodSecurityContext {
+	if sc == nil {
+		return nil
+	}
+	return &corev1.PodSecurityContext{
+		RunAsUser:    sc.RunAsUser,
+		RunAsGroup:   sc.RunAsGroup,
+		RunAsNonRoot: sc.RunAsNonRoot,
+		FSGroup:      sc.FSGroup,
+	}
+}
+
+func lifecycleToK8s(l *domain.Lifecycle) *corev1.Lifecycle {
+	if l == nil || (l.PostStart == nil && l.PreStop == nil) {
+		return nil
+	}
+	return &corev1.Lifecycle{
+		PostStart: lifecycleHandlerToK8s(l.PostStart),
+		PreStop:   lifecycleHandlerToK8s(l.PreStop),
+	}
+}
+
+func lifecycleHandlerToK8s(h *domain.LifecycleHandler) *corev1.LifecycleHandler {
+	switch {
+	case h == nil:
+		return nil
+	case h.Exec != nil:
+		return &corev1.LifecycleHandler{Exec: &corev1.ExecAction{Command: h.Exec.Command}}
+	case h.HTTPGet != nil:
+		return &corev1.LifecycleHandler{HTTPGet: httpGetToK8s(h.HTTPGet)}
+	}
+	return nil
+}
+
+func imagePullSecretsToK8s(names []string) []corev1.LocalObjectReference {
+	var result []corev1.LocalObjectReference
+	for _, name := range names {
+		result = append(result, corev1.LocalObjectReference{Name: name})
+	}
+	return result
+}
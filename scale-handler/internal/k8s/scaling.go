@@ -0,0 +1,75 @@
+package k8s
+
+import (
+	"scale-handler/internal/domain"
+)
+
+const defaultCooldownPeriod = 300
+
+// applyScalingOptions заполняет параметры масштабирования в spec ScaledObject.
+// Вне окон HPA держит minReplicaCount, поэтому он поднимается до defaultReplicas.
+func applyScalingOptions(spec map[string]interface{}, rules *domain.ScheduleRules) {
+	opts := rules.Scaling
+	if opts == nil {
+		opts = &domain.ScalingOptions{}
+	}
+
+	minReplicas, maxReplicas := rules.ReplicaBounds()
+	spec["minReplicaCount"] = int64(minReplicas)
+	spec["maxReplicaCount"] = int64(maxReplicas)
+	spec["cooldownPeriod"] = int64(valueOr(opts.CooldownPeriod, defaultCooldownPeriod))
+	if opts.PollingInterval != nil {
+		spec["pollingInterval"] = int64(*opts.PollingInterval)
+	}
+
+	if opts.Fallback != nil {
+		spec["fallback"] = map[string]interface{}{
+			"failureThreshold": int64(opts.Fallback.FailureThreshold),
+			"replicas":         int64(opts.Fallback.Replicas),
+		}
+	}
+
+	if opts.Behavior != nil {
+		behavior := map[string]interface{}{}
+		if opts.Behavior.ScaleUp != nil {
+			behavior["scaleUp"] = scalingRulesToK8s(opts.Behavior.ScaleUp)
+		}
+		if opts.Behavior.ScaleDown != nil {
+			behavior["scaleDown"] = scalingRulesToK8s(opts.Behavior.ScaleDown)
+		}
+		spec["advanced"] = map[string]interface{}{
+			"horizontalPodAutoscalerConfig": map[string]interface{}{
+				"behavior": behavior,
+			},
+		}
+	}
+}
+
+func scalingRulesToK8s(r *domain.ScalingRules) map[string]interface{} {
+	rules := map[string]interface{}{}
+	if r.StabilizationWindowSeconds != nil {
+		rules["stabilizationWindowSeconds"] = int64(*r.StabilizationWindowSeconds)
+	}
+	if r.SelectPolicy != "" {
+		rules["selectPolicy"] = r.SelectPolicy
+	}
+	if len(r.Policies) > 0 {
+		policies := make([]interface{}, len(r.Policies))
+		for i, p := range r.Policies {
+			policies[i] = map[string]interface{}{
+				"type":          p.Type,
+				"value":         int64(p.Value),
+				"periodSeconds": int64(p.PeriodSeconds),
+			}
+		}
+		rules["policies"] = policies
+	}
+	return rules
+}
+
+func valueOr(v *int32, def int32) int32 {
+	if v == nil {
+		return def
+	}
+	return *v
+}
@@ -0,0 +1,79 @@
+package k8s
+
+import (
+	"reflect"
+	"testing"
+
+	"scale-handler/internal/domain"
+)
+
+func TestApplyScalingOptions(t *testing.T) {
+	tests := []struct {
+		name  string
+		rules domain.ScheduleRules
+		want  map[string]interface{}
+	}{
+		{
+			name: "defaults",
+			want: map[string]interface{}{
+				"minReplicaCount": int64(0),
+				"maxReplicaCount": int64(100),
+				"cooldownPeriod":  int64(300),
+			},
+		},
+		{
+			name: "explicit options",
+			rules: domain.ScheduleRules{Scaling: &domain.ScalingOptions{
+				MinReplicaCount: int32Ptr(1),
+				MaxReplicaCount: int32Ptr(10),
+				PollingInterval: int32Ptr(15),
+				Fallback:        &domain.Fallback{FailureThreshold: 3, Replicas: 2},
+				Behavior: &domain.ScalingBehavior{ScaleDown: &domain.ScalingRules{
+					StabilizationWindowSeconds: int32Ptr(600),
+					Policies:                   []domain.ScalingPolicy{{Type: "Pods", Value: 1, PeriodSeconds: 60}},
+				}},
+			}},
+			want: map[string]interface{}{
+				"minReplicaCount": int64(1),
+				"maxReplicaCount": int64(10),
+				"cooldownPeriod":  int64(300),
+				"pollingInterval": int64(15),
+				"fallback":        map[string]interface{}{"failureThreshold": int64(3), "replicas": int64(2)},
+				"advanced": map[string]interface{}{
+					"horizontalPodAutoscalerConfig": map[string]interface{}{
+						"behavior": map[string]interface{}{
+							"scaleDown": map[string]interface{}{
+								"stabilizationWindowSeconds": int64(600),
+								"policies": []interface{}{
+									map[string]interface{}{"type": "Pods", "value": int64(1), "periodSeconds": int64(60)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "defaultReplicas raises minReplicaCount",
+			rules: domain.ScheduleRules{
+				DefaultReplicas: 2,
+				Scaling:         &domain.ScalingOptions{MinReplicaCount: int32Ptr(1), MaxReplicaCount: int32Ptr(5)},
+			},
+			want: map[string]interface{}{
+				"minReplicaCount": int64(2),
+				"maxReplicaCount": int64(5),
+				"cooldownPeriod":  int64(300),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := map[string]interface{}{}
+			applyScalingOptions(spec, &tt.rules)
+			if !reflect.DeepEqual(spec, tt.want) {
+				t.Errorf("applyScalingOptions() =\n%v\nwant\n%v", spec, tt.want)
+			}
+		})
+	}
+}
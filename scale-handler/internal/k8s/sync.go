@@ -0,0 +1,182 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"scale-handler/internal/domain"
+)
+
+// SyncResources приводит ресурсы расписания к желаемому состоянию и
+// возвращает список внесённых изменений. Расхождение определяется через
+// dry-run apply: если его результат отличается от живого объекта, объект
+// разошёлся с расписанием.
+// Триггеры перестраиваются от текущей даты, поэтому сверка заодно сдвигает
+// горизонт исключений и удаляет прошедшие даты.
+func (r *Reconciler) SyncResources(ctx context.Context, schedule *domain.Schedule) ([]domain.ResourceChange, error) {
+	hasApplication := schedule.Application != nil && len(schedule.Application.Containers) > 0
+	if schedule.Target == nil && !hasApplication {
+		return nil, nil
+	}
+
+	meta := r.objectMeta(schedule)
+	ns := meta.Namespace
+	var changes []domain.ResourceChange
+	record := func(kind, name, action string) {
+		if action != "" {
+			changes = append(changes, domain.ResourceChange{
+				ScheduleID: schedule.ID,
+				Kind:       kind,
+				Namespace:  ns,
+				Name:       name,
+				Action:     action,
+			})
+		}
+	}
+
+	// Чужой workload только проверяется, но не создаётся и не исправляется
+	scaledObjectMeta := withPause(meta, schedule.Pause)
+	if schedule.Target != nil {
+		if err := r.checkTarget(ctx, ns, schedule.Target); err != nil {
+			return nil, err
+		}
+	} else {
+		if err := r.syncWorkload(ctx, meta, schedule, record); err != nil {
+			return changes, err
+		}
+		owner, err := r.workloadOwner(ctx, meta, schedule.Application)
+		if err != nil {
+			return changes, err
+		}
+		scaledObjectMeta.OwnerReferences = []metav1.OwnerReference{owner}
+	}
+
+	scaledObject := r.buildScaledObject(scaledObjectMeta, schedule)
+	action, err := r.syncObject(ctx, scaledObjectGVR(), scaledObject, func() error {
+		_, err := r.apply(ctx, scaledObjectGVR(), scaledObject)
+		return err
+	})
+	record(scaledObjectKind, scaledObject.GetName(), action)
+	if err != nil {
+		return changes, fmt.Errorf("sync ScaledObject: %w", err)
+	}
+
+	return changes, nil
+}
+
+// syncWorkload сверяет workload из Application с его Service и Ingress, а
+// для StatefulSet ещё и headless Service
+func (r *Reconciler) syncWorkload(ctx context.Context, meta metav1.ObjectMeta, schedule *domain.Schedule, record func(kind, name, action string)) error {
+	name, ns, app := meta.Name, meta.Namespace, schedule.Application
+	if err := r.ensureNamespace(ctx, ns); err != nil {
+		return err
+	}
+
+	if app.WorkloadKind() == domain.KindStatefulSet {
+		if err := r.syncApplied(ctx, serviceGVR(), r.buildHeadlessService(meta, app), record); err != nil {
+			return fmt.Errorf("sync headless service: %w", err)
+		}
+	}
+
+	workload, gvr, err := r.buildWorkload(meta, app, schedule.Rules.DefaultReplicas)
+	if err != nil {
+		return fmt.Errorf("build %s: %w", strings.ToLower(app.WorkloadKind()), err)
+	}
+	obj, err := toApplyObject(workload)
+	if err != nil {
+		return err
+	}
+	unstructured.RemoveNestedField(obj.Object, "spec", "replicas")
+	action, err := r.syncObject(ctx, gvr, obj, func() error {
+		return r.applyWorkload(ctx, meta, app, schedule.Rules.DefaultReplicas)
+	})
+	record(app.WorkloadKind(), obj.GetName(), action)
+	if err != nil {
+		return fmt.Errorf("sync %s: %w", strings.ToLower(app.WorkloadKind()), err)
+	}
+
+	if app.Service != nil {
+		if err := r.syncApplied(ctx, serviceGVR(), r.buildService(meta, app), record); err != nil {
+			return fmt.Errorf("sync service: %w", err)
+		}
+	} else if err := r.syncDeleted(ctx, serviceGVR(), ns, serviceName(name), "Service", record); err != nil {
+		return fmt.Errorf("sync service: %w", err)
+	}
+	if app.Ingress != nil {
+		if err := r.syncApplied(ctx, ingressGVR(), r.buildIngress(meta, app), record); err != nil {
+			return fmt.Errorf("sync ingress: %w", err)
+		}
+	} else if err := r.syncDeleted(ctx, ingressGVR(), ns, name, "Ingress", record); err != nil {
+		return fmt.Errorf("sync ingress: %w", err)
+	}
+	return nil
+}
+
+// syncApplied сверяет объект, который целиком задаётся через apply
+func (r *Reconciler) syncApplied(ctx context.Context, gvr schema.GroupVersionResource, typed runtime.Object, record func(kind, name, action string)) error {
+	obj, err := toApplyObject(typed)
+	if err != nil {
+		return err
+	}
+	action, err := r.syncObject(ctx, gvr, obj, func() error {
+		_, err := r.apply(ctx, gvr, obj)
+		return err
+	})
+	record(obj.GetKind(), obj.GetName(), action)
+	return err
+}
+
+// syncDeleted удаляет объект, который больше не нужен расписанию
+func (r *Reconciler) syncDeleted(ctx context.Context, gvr schema.GroupVersionResource, ns, name, kind string, record func(kind, name, action string)) error {
+	deleted, err := r.deleteOwned(ctx, gvr, ns, name)
+	if deleted {
+		record(kind, name, domain.ActionDeleted)
+	}
+	return err
+}
+
+// syncObject сравнивает живой объект с желаемым и применяет его при
+// расхождении. Возвращает выполненное действие или пустую строку.
+func (r *Reconciler) syncObject(ctx context.Context, gvr schema.GroupVersionResource, obj *unstructured.Unstructured, create func() error) (string, error) {
+	client := r.dynamic.Resource(gvr).Namespace(obj.GetNamespace())
+
+	live, err := client.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if err := create(); err != nil {
+			return "", err
+		}
+		return domain.ActionCreated, nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	dryRun, err := r.applyDryRun(ctx, gvr, obj)
+	if err != nil {
+		return "", fmt.Errorf("dry-run apply: %w", err)
+	}
+	if !changed(live, dryRun) {
+		return "", nil
+	}
+
+	if _, err := r.apply(ctx, gvr, obj); err != nil {
+		return "", err
+	}
+	return domain.ActionUpdated, nil
+}
+
+// changed сравнивает spec и метаданные, которыми управляет scale-handler.
+// generation для этого не годится: у Service его нет, а метки его не меняют.
+func changed(live, desired *unstructured.Unstructured) bool {
+	return !equality.Semantic.DeepEqual(live.Object["spec"], desired.Object["spec"]) ||
+		!equality.Semantic.DeepEqual(live.GetLabels(), desired.GetLabels()) ||
+		!equality.Semantic.DeepEqual(live.GetAnnotations(), desired.GetAnnotations())
+}
@@ -0,0 +1,116 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"scale-handler/internal/domain"
+)
+
+// defaultTargetAPIVersions - apiVersion по умолчанию для встроенных видов
+var defaultTargetAPIVersions = map[string]string{
+	domain.KindDeployment:  "apps/v1",
+	domain.KindStatefulSet: "apps/v1",
+}
+
+// applyTarget создаёт или обновляет ScaledObject для существующего workload.
+// Сам workload не создаётся и не изменяется.
+func (r *Reconciler) applyTarget(ctx context.Context, schedule *domain.Schedule) error {
+	meta := r.objectMeta(schedule)
+	if err := r.checkTarget(ctx, meta.Namespace, schedule.Target); err != nil {
+		return err
+	}
+	// Ссылку на владельца не ставим: чужой workload не должен управлять
+	// жизнью ScaledObject, за это отвечает расписание
+	return r.applyScaledObject(ctx, withPause(meta, schedule.Pause), schedule)
+}
+
+// checkTarget проверяет через discovery, что вид цели существует и
+// поддерживает подресурс /scale, и что сам объект есть в кластере
+func (r *Reconciler) checkTarget(ctx context.Context, ns string, target *domain.TargetRef) error {
+	gvr, err := r.resolveTarget(target)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.dynamic.Resource(gvr).Namespace(ns).Get(ctx, target.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return fmt.Errorf("target %s %s/%s not found", targetKind(target), ns, target.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("get target: %w", err)
+	}
+	return nil
+}
+
+// resolveTarget находит ресурс для вида цели
+func (r *Reconciler) resolveTarget(target *domain.TargetRef) (schema.GroupVersionResource, error) {
+	kind, apiVersion := targetKind(target), targetAPIVersion(target)
+	if apiVersion == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("apiVersion is required for target kind %s", kind)
+	}
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("invalid target apiVersion %s: %w", apiVersion, err)
+	}
+
+	resources, err := r.clientset.Discovery().ServerResourcesForGroupVersion(apiVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("discover %s: %w", apiVersion, err)
+	}
+
+	var resource string
+	scalable := map[string]bool{}
+	for _, res := range resources.APIResources {
+		if res.Kind == kind && res.Namespaced && !strings.Contains(res.Name, "/") {
+			resource = res.Name
+		}
+		// подресурсы имеют вид "deployments/scale"
+		if parent, sub, ok := strings.Cut(res.Name, "/"); ok && sub == "scale" {
+			scalable[parent] = true
+		}
+	}
+	if resource == "" {
+		return schema.GroupVersionResource{}, fmt.Errorf("target kind %s not found in %s", kind, apiVersion)
+	}
+	if !scalable[resource] {
+		return schema.GroupVersionResource{}, fmt.Errorf("target kind %s has no /scale subresource", kind)
+	}
+	return gv.WithResource(resource), nil
+}
+
+// scaleTargetRef указывает ScaledObject на существующий workload или на
+// workload, созданный из Application
+func scaleTargetRef(schedule *domain.Schedule) map[string]interface{} {
+	if schedule.Target == nil {
+		return map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       schedule.Application.WorkloadKind(),
+			"name":       schedule.ID,
+		}
+	}
+	return map[string]interface{}{
+		"apiVersion": targetAPIVersion(schedule.Target),
+		"kind":       targetKind(schedule.Target),
+		"name":       schedule.Target.Name,
+	}
+}
+
+func targetKind(target *domain.TargetRef) string {
+	if target.Kind == "" {
+		return domain.KindDeployment
+	}
+	return target.Kind
+}
+
+func targetAPIVersion(target *domain.TargetRef) string {
+	if target.APIVersion != "" {
+		return target.APIVersion
+	}
+	return defaultTargetAPIVersions[targetKind(target)]
+}
@@ -0,0 +1,52 @@
+package k8s
+
+import (
+	"reflect"
+	"testing"
+
+	"scale-handler/internal/domain"
+)
+
+func TestScaleTargetRef(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule domain.Schedule
+		want     map[string]interface{}
+	}{
+		{
+			name:     "generated deployment",
+			schedule: domain.Schedule{ID: "sched-1"},
+			want:     map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "sched-1"},
+		},
+		{
+			name:     "generated statefulset",
+			schedule: domain.Schedule{ID: "sched-1", Application: &domain.Application{Kind: domain.KindStatefulSet}},
+			want:     map[string]interface{}{"apiVersion": "apps/v1", "kind": "StatefulSet", "name": "sched-1"},
+		},
+		{
+			name:     "target defaults to deployment",
+			schedule: domain.Schedule{ID: "sched-1", Target: &domain.TargetRef{Name: "web"}},
+			want:     map[string]interface{}{"apiVersion": "apps/v1", "kind": "Deployment", "name": "web"},
+		},
+		{
+			name:     "statefulset target",
+			schedule: domain.Schedule{ID: "sched-1", Target: &domain.TargetRef{Kind: "StatefulSet", Name: "db"}},
+			want:     map[string]interface{}{"apiVersion": "apps/v1", "kind": "StatefulSet", "name": "db"},
+		},
+		{
+			name: "custom scalable kind",
+			schedule: domain.Schedule{ID: "sched-1", Target: &domain.TargetRef{
+				APIVersion: "argoproj.io/v1alpha1", Kind: "Rollout", Name: "web",
+			}},
+			want: map[string]interface{}{"apiVersion": "argoproj.io/v1alpha1", "kind": "Rollout", "name": "web"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := scaleTargetRef(&tt.schedule); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("scaleTargetRef() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
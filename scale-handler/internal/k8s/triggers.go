@@ -0,0 +1,323 @@
+package k8s
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"scale-handler/internal/domain"
+)
+
+const dateLayout = "2006-01-02"
+
+// exceptionHorizonMonths - на сколько месяцев вперёд учитываются исключения.
+// В cron нет года, поэтому месяц в триггере должен однозначно указывать на год.
+const exceptionHorizonMonths = 12
+
+var weekdayOrder = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+var weekdays = map[string]time.Weekday{
+	"monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday, "thursday": time.Thursday,
+	"friday": time.Friday, "saturday": time.Saturday, "sunday": time.Sunday,
+}
+
+// buildTriggers раскладывает правила расписания в cron-триггеры KEDA.
+// Cron не умеет исключать отдельные даты, поэтому для месяцев, в которых
+// есть исключения, окна дня недели разворачиваются в конкретные даты,
+// а общий триггер ограничивается остальными месяцами.
+func buildTriggers(rules *domain.ScheduleRules, loc *time.Location, now time.Time) []map[string]interface{} {
+	now = now.In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	horizon := time.Date(today.Year(), today.Month()+exceptionHorizonMonths, 1, 0, 0, 0, 0, loc)
+	p := &triggerPlanner{
+		loc: loc,
+		// Окно, начатое вчера, может ещё продолжаться после полуночи
+		since:      today.AddDate(0, 0, -1),
+		horizon:    horizon,
+		exceptions: activeExceptions(rules.Exceptions, today, horizon),
+	}
+
+	triggers := []map[string]interface{}{}
+
+	for _, day := range sortedWeekdays(rules.Weekdays) {
+		wd := weekdays[strings.ToLower(day)]
+		for _, tr := range rules.Weekdays[day] {
+			triggers = append(triggers, p.weekdayTriggers(wd, tr)...)
+		}
+	}
+
+	// Триггер по дате повторяется ежегодно, поэтому прошедшие даты удаляются,
+	// а даты дальше чем через год откладываются до следующей перерисовки.
+	yearAhead := today.AddDate(1, 0, 0)
+	for _, dateStr := range sortedKeys(rules.Dates) {
+		date, err := time.ParseInLocation(dateLayout, dateStr, loc)
+		if err != nil || date.Before(p.since) || !date.Before(yearAhead) {
+			continue
+		}
+		if _, ok := p.exceptions[date]; ok {
+			continue
+		}
+		for _, tr := range rules.Dates[dateStr] {
+			triggers = append(triggers, dateTrigger(date, tr.From, tr.To, tr.Replicas))
+		}
+	}
+
+	return triggers
+}
+
+type triggerPlanner struct {
+	loc        *time.Location
+	since      time.Time
+	horizon    time.Time
+	exceptions map[time.Time]struct{}
+}
+
+// weekdayTriggers строит триггеры для окна дня недели. Окно через полночь
+// задаётся одним триггером с концом на следующий день недели; если же
+// приходится ограничивать месяцы, оно делится на части до и после полуночи,
+// и каждая часть исключается по дню, в который окно началось.
+func (p *triggerPlanner) weekdayTriggers(wd time.Weekday, tr domain.TimeRange) []map[string]interface{} {
+	if !crossesMidnight(tr.From, tr.To) {
+		return p.sameDayTriggers(wd, tr.From, tr.To, tr.Replicas, p.exceptions)
+	}
+
+	next := (wd + 1) % 7
+	shifted := make(map[time.Time]struct{}, len(p.exceptions))
+	for date := range p.exceptions {
+		// Сдвиг за горизонт дал бы месяц, совпадающий с текущим в cron
+		if next := date.AddDate(0, 0, 1); next.Before(p.horizon) {
+			shifted[next] = struct{}{}
+		}
+	}
+
+	if len(p.excludedMonths(wd, p.exceptions)) == 0 && len(p.excludedMonths(next, shifted)) == 0 {
+		start := timeToCron(tr.From, "*", "*", strconv.Itoa(int(wd)))
+		end := timeToCron(endOfDay(tr.To), "*", "*", strconv.Itoa(int(next)))
+		return []map[string]interface{}{cronTrigger(p.loc, start, end, tr.Replicas)}
+	}
+
+	triggers := p.sameDayTriggers(wd, tr.From, "24:00", tr.Replicas, p.exceptions)
+	if tr.To != "24:00" && minutes(tr.To) > 0 {
+		triggers = append(triggers, p.sameDayTriggers(next, "00:00", tr.To, tr.Replicas, shifted)...)
+	}
+	return triggers
+}
+
+// sameDayTriggers строит триггеры для окна в пределах одного дня недели
+func (p *triggerPlanner) sameDayTriggers(wd time.Weekday, from, to string, replicas int32, exceptions map[time.Time]struct{}) []map[string]interface{} {
+	dow := strconv.Itoa(int(wd))
+	excludedMonths := p.excludedMonths(wd, exceptions)
+
+	if len(excludedMonths) == 0 {
+		start := timeToCron(from, "*", "*", dow)
+		end := timeToCron(to, "*", "*", dow)
+		if to == "24:00" {
+			end = timeToCron("00:00", "*", "*", strconv.Itoa(int((wd+1)%7)))
+		}
+		return []map[string]interface{}{cronTrigger(p.loc, start, end, replicas)}
+	}
+
+	var triggers []map[string]interface{}
+	var allowed []string
+	for m := time.January; m <= time.December; m++ {
+		if !containsMonth(excludedMonths, m) {
+			allowed = append(allowed, strconv.Itoa(int(m)))
+		}
+	}
+	if len(allowed) > 0 {
+		months := strings.Join(allowed, ",")
+		start := timeToCron(from, "*", months, dow)
+		end := timeToCron(to, "*", months, dow)
+		if to == "24:00" {
+			// Конец должен попасть в тот же месяц, что и начало, поэтому
+			// полночь заменяется на 23:59
+			end = timeToCron("23:59", "*", months, dow)
+		}
+		triggers = append(triggers, cronTrigger(p.loc, start, end, replicas))
+	}
+
+	for _, first := range sortedMonths(excludedMonths) {
+		for date := first; date.Month() == first.Month(); date = date.AddDate(0, 0, 1) {
+			if date.Weekday() != wd || date.Before(p.since) {
+				continue
+			}
+			if _, ok := exceptions[date]; ok {
+				continue
+			}
+			triggers = append(triggers, dateTrigger(date, from, to, replicas))
+		}
+	}
+	return triggers
+}
+
+// excludedMonths возвращает первые числа месяцев (с учётом года), в которых
+// день недели попадает на исключение
+func (p *triggerPlanner) excludedMonths(wd time.Weekday, exceptions map[time.Time]struct{}) map[time.Time]struct{} {
+	months := map[time.Time]struct{}{}
+	for date := range exceptions {
+		if date.Weekday() == wd {
+			months[time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, p.loc)] = struct{}{}
+		}
+	}
+	return months
+}
+
+func containsMonth(months map[time.Time]struct{}, m time.Month) bool {
+	for first := range months {
+		if first.Month() == m {
+			return true
+		}
+	}
+	return false
+}
+
+// activeExceptions возвращает исключения, которые ещё не наступили и попадают
+// в горизонт планирования. Прошедшие даты отбрасываются, чтобы набор
+// триггеров не разрастался.
+func activeExceptions(exceptions []string, today, horizon time.Time) map[time.Time]struct{} {
+	result := make(map[time.Time]struct{}, len(exceptions))
+	for _, s := range exceptions {
+		date, err := time.ParseInLocation(dateLayout, s, today.Location())
+		if err != nil || date.Before(today) || !date.Before(horizon) {
+			continue
+		}
+		result[date] = struct{}{}
+	}
+	return result
+}
+
+// dateTrigger строит триггер на конкретную дату; окно через полночь
+// заканчивается на следующий день
+func dateTrigger(date time.Time, from, to string, replicas int32) map[string]interface{} {
+	end := date
+	if crossesMidnight(from, to) {
+		end = date.AddDate(0, 0, 1)
+	}
+	start := timeToCron(from, strconv.Itoa(date.Day()), strconv.Itoa(int(date.Month())), "*")
+	stop := timeToCron(endOfDay(to), strconv.Itoa(end.Day()), strconv.Itoa(int(end.Month())), "*")
+	return cronTrigger(date.Location(), start, stop, replicas)
+}
+
+func cronTrigger(loc *time.Location, start, end string, replicas int32) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "cron",
+		"metadata": map[string]interface{}{
+			"timezone":        loc.String(),
+			"start":           start,
+			"end":             end,
+			"desiredReplicas": strconv.Itoa(int(replicas)),
+		},
+	}
+}
+
+func timeToCron(timeStr, day, month, dow string) string {
+	parts := strings.Split(timeStr, ":")
+	if len(parts) != 2 {
+		return "0 0 * * *"
+	}
+	// Cron: minute hour day month day-of-week
+	minute, hour := parts[1], parts[0]
+	return fmt.Sprintf("%s %s %s %s %s", minute, hour, day, month, dow)
+}
+
+// minutes возвращает количество минут от начала суток для HH:MM
+func minutes(timeStr string) int {
+	hour, minute, _ := strings.Cut(timeStr, ":")
+	h, _ := strconv.Atoi(hour)
+	m, _ := strconv.Atoi(minute)
+	return h*60 + m
+}
+
+// crossesMidnight сообщает, что окно заканчивается на следующий день:
+// "22:00-06:00" или "18:00-24:00"
+func crossesMidnight(from, to string) bool {
+	return to == "24:00" || minutes(to) <= minutes(from)
+}
+
+// endOfDay переводит "24:00" в полночь следующего дня
+func endOfDay(timeStr string) string {
+	if timeStr == "24:00" {
+		return "00:00"
+	}
+	return timeStr
+}
+
+func sortedWeekdays(m map[string][]domain.TimeRange) []string {
+	var days []string
+	for _, day := range weekdayOrder {
+		for key := range m {
+			if strings.ToLower(key) == day {
+				days = append(days, key)
+			}
+		}
+	}
+	return days
+}
+
+func sortedKeys(m map[string][]domain.TimeRange) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedMonths(m map[time.Time]struct{}) []time.Time {
+	months := make([]time.Time, 0, len(m))
+	for first := range m {
+		months = append(months, first)
+	}
+	sort.Slice(months, func(i, j int) bool { return months[i].Before(months[j]) })
+	return months
+}
+
+// overrideTriggers строит разовые cron-триггеры для переопределений. Cron не
+// знает года, поэтому переопределение короче года; истёкшие пропускаются, и
+// сверка убирает их из ScaledObject, иначе триггер сработал бы через год.
+func overrideTriggers(overrides []domain.Override, loc *time.Location, now time.Time) []map[string]interface{} {
+	var triggers []map[string]interface{}
+	for _, o := range overrides {
+		if !now.Before(o.End) {
+			continue
+		}
+		start, end := o.Start.In(loc), o.End.In(loc)
+		triggers = append(triggers, cronTrigger(loc,
+			timeToCron(start.Format("15:04"), strconv.Itoa(start.Day()), strconv.Itoa(int(start.Month())), "*"),
+			timeToCron(end.Format("15:04"), strconv.Itoa(end.Day()), strconv.Itoa(int(end.Month())), "*"),
+			o.Replicas))
+	}
+	return triggers
+}
+
+// metricTriggers переводит метрические триггеры расписания в триггеры KEDA
+func metricTriggers(triggers []domain.MetricTrigger) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(triggers))
+	for _, t := range triggers {
+		metadata := make(map[string]interface{}, len(t.Metadata))
+		for k, v := range t.Metadata {
+			metadata[k] = v
+		}
+		trigger := map[string]interface{}{
+			"type":     t.Type,
+			"metadata": metadata,
+		}
+		if t.Name != "" {
+			trigger["name"] = t.Name
+		}
+		if t.MetricType != "" {
+			trigger["metricType"] = t.MetricType
+		}
+		if ref := t.AuthenticationRef; ref != nil {
+			auth := map[string]interface{}{"name": ref.Name}
+			if ref.Kind != "" {
+				auth["kind"] = ref.Kind
+			}
+			trigger["authenticationRef"] = auth
+		}
+		result = append(result, trigger)
+	}
+	return result
+}
@@ -0,0 +1,254 @@
+package k8s
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"scale-handler/internal/domain"
+)
+
+// cronWindows сводит триггеры к строкам "start|end|replicas"
+func cronWindows(triggers []map[string]interface{}) []string {
+	result := make([]string, 0, len(triggers))
+	for _, t := range triggers {
+		md := t["metadata"].(map[string]interface{})
+		result = append(result, md["start"].(string)+"|"+md["end"].(string)+"|"+md["desiredReplicas"].(string))
+	}
+	return result
+}
+
+const otherMonths = "1,2,3,5,6,7,8,9,10,11,12"
+
+func TestBuildTriggers(t *testing.T) {
+	// Понедельник, 10 марта 2025
+	now := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		rules domain.ScheduleRules
+		want  []string
+	}{
+		{
+			name: "weekday window",
+			rules: domain.ScheduleRules{Weekdays: map[string][]domain.TimeRange{
+				"monday": {{From: "09:00", To: "18:00", Replicas: 3}},
+			}},
+			want: []string{"00 09 * * 1|00 18 * * 1|3"},
+		},
+		{
+			name: "window until 24:00 ends at next midnight",
+			rules: domain.ScheduleRules{Weekdays: map[string][]domain.TimeRange{
+				"Monday": {{From: "18:00", To: "24:00", Replicas: 2}},
+			}},
+			want: []string{"00 18 * * 1|00 00 * * 2|2"},
+		},
+		{
+			name: "overnight window ends next weekday",
+			rules: domain.ScheduleRules{Weekdays: map[string][]domain.TimeRange{
+				"sunday":   {{From: "22:00", To: "06:00", Replicas: 4}},
+				"saturday": {{From: "23:00", To: "01:00", Replicas: 5}},
+			}},
+			want: []string{"00 23 * * 6|00 01 * * 0|5", "00 22 * * 0|00 06 * * 1|4"},
+		},
+		{
+			name: "exception month is expanded into dates",
+			rules: domain.ScheduleRules{
+				Weekdays: map[string][]domain.TimeRange{
+					"monday": {{From: "09:00", To: "18:00", Replicas: 3}},
+				},
+				Exceptions: []string{"2025-04-14"},
+			},
+			want: []string{
+				"00 09 * " + otherMonths + " 1|00 18 * " + otherMonths + " 1|3",
+				"00 09 7 4 *|00 18 7 4 *|3",
+				"00 09 21 4 *|00 18 21 4 *|3",
+				"00 09 28 4 *|00 18 28 4 *|3",
+			},
+		},
+		{
+			name: "exception on another weekday and past exception are ignored",
+			rules: domain.ScheduleRules{
+				Weekdays: map[string][]domain.TimeRange{
+					"monday": {{From: "09:00", To: "18:00", Replicas: 3}},
+				},
+				Exceptions: []string{"2025-04-15", "2025-01-06"},
+			},
+			want: []string{"00 09 * * 1|00 18 * * 1|3"},
+		},
+		{
+			name: "overnight window with exception is split at midnight",
+			rules: domain.ScheduleRules{
+				Weekdays: map[string][]domain.TimeRange{
+					"monday": {{From: "22:00", To: "06:00", Replicas: 2}},
+				},
+				Exceptions: []string{"2025-04-14"},
+			},
+			want: []string{
+				"00 22 * " + otherMonths + " 1|59 23 * " + otherMonths + " 1|2",
+				"00 22 7 4 *|00 00 8 4 *|2",
+				"00 22 21 4 *|00 00 22 4 *|2",
+				"00 22 28 4 *|00 00 29 4 *|2",
+				"00 00 * " + otherMonths + " 2|00 06 * " + otherMonths + " 2|2",
+				"00 00 1 4 *|00 06 1 4 *|2",
+				"00 00 8 4 *|00 06 8 4 *|2",
+				"00 00 22 4 *|00 06 22 4 *|2",
+				"00 00 29 4 *|00 06 29 4 *|2",
+			},
+		},
+		{
+			name: "exception beyond the horizon is ignored",
+			rules: domain.ScheduleRules{
+				Weekdays: map[string][]domain.TimeRange{
+					"monday": {{From: "09:00", To: "18:00", Replicas: 3}},
+				},
+				Exceptions: []string{"2026-03-02"},
+			},
+			want: []string{"00 09 * * 1|00 18 * * 1|3"},
+		},
+		{
+			// 1 марта 2026 уже за горизонтом: в cron без года оно совпало бы
+			// с текущим мартом
+			name: "exception shifted past the horizon is ignored",
+			rules: domain.ScheduleRules{
+				Weekdays: map[string][]domain.TimeRange{
+					"saturday": {{From: "22:00", To: "06:00", Replicas: 2}},
+				},
+				Exceptions: []string{"2026-02-28"},
+			},
+			want: []string{
+				"00 22 * 1,3,4,5,6,7,8,9,10,11,12 6|59 23 * 1,3,4,5,6,7,8,9,10,11,12 6|2",
+				"00 22 7 2 *|00 00 8 2 *|2",
+				"00 22 14 2 *|00 00 15 2 *|2",
+				"00 22 21 2 *|00 00 22 2 *|2",
+				"00 00 * * 0|00 06 * * 0|2",
+			},
+		},
+		{
+			name: "date window rolls over to new year",
+			rules: domain.ScheduleRules{Dates: map[string][]domain.TimeRange{
+				"2025-12-31": {{From: "22:00", To: "02:00", Replicas: 5}},
+			}},
+			want: []string{"00 22 31 12 *|00 02 1 1 *|5"},
+		},
+		{
+			name: "past, excepted and distant dates are skipped",
+			rules: domain.ScheduleRules{
+				Dates: map[string][]domain.TimeRange{
+					"2025-03-01": {{From: "10:00", To: "12:00", Replicas: 1}},
+					"2025-03-09": {{From: "22:00", To: "02:00", Replicas: 2}},
+					"2025-05-01": {{From: "10:00", To: "12:00", Replicas: 3}},
+					"2025-06-01": {{From: "10:00", To: "12:00", Replicas: 4}},
+					"2026-03-10": {{From: "10:00", To: "12:00", Replicas: 5}},
+				},
+				Exceptions: []string{"2025-05-01"},
+			},
+			want: []string{
+				"00 22 9 3 *|00 02 10 3 *|2",
+				"00 10 1 6 *|00 12 1 6 *|4",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := cronWindows(buildTriggers(&tt.rules, time.UTC, now))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("buildTriggers() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildTriggersTimezone(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Tokyo")
+	if err != nil {
+		t.Skip(err)
+	}
+	// 23:30 UTC - в Токио уже вторник, поэтому вчерашнее окно - понедельник
+	now := time.Date(2025, time.March, 10, 23, 30, 0, 0, time.UTC)
+	rules := domain.ScheduleRules{Dates: map[string][]domain.TimeRange{
+		"2025-03-09": {{From: "10:00", To: "12:00", Replicas: 1}},
+		"2025-03-10": {{From: "22:00", To: "02:00", Replicas: 2}},
+	}}
+
+	triggers := buildTriggers(&rules, loc, now)
+	want := []string{"00 22 10 3 *|00 02 11 3 *|2"}
+	if got := cronWindows(triggers); !reflect.DeepEqual(got, want) {
+		t.Errorf("buildTriggers() = %q, want %q", got, want)
+	}
+	if tz := triggers[0]["metadata"].(map[string]interface{})["timezone"]; tz != "Asia/Tokyo" {
+		t.Errorf("timezone = %v, want Asia/Tokyo", tz)
+	}
+}
+
+func TestMetricTriggers(t *testing.T) {
+	triggers := metricTriggers([]domain.MetricTrigger{
+		{Type: "cpu", MetricType: "Utilization", Metadata: map[string]string{"value": "70"}},
+		{
+			Type:              "prometheus",
+			Name:              "rps",
+			Metadata:          map[string]string{"serverAddress": "http://prometheus:9090", "query": "sum(rate(http_requests_total[1m]))", "threshold": "100"},
+			AuthenticationRef: &domain.AuthenticationRef{Name: "prometheus-auth", Kind: "ClusterTriggerAuthentication"},
+		},
+	})
+	if len(triggers) != 2 {
+		t.Fatalf("metricTriggers() returned %d triggers, want 2", len(triggers))
+	}
+
+	cpu := triggers[0]
+	if cpu["type"] != "cpu" || cpu["metricType"] != "Utilization" {
+		t.Errorf("cpu trigger = %v", cpu)
+	}
+	if _, ok := cpu["authenticationRef"]; ok {
+		t.Errorf("cpu trigger has authenticationRef: %v", cpu)
+	}
+	if _, ok := cpu["name"]; ok {
+		t.Errorf("cpu trigger has name: %v", cpu)
+	}
+
+	prometheus := triggers[1]
+	if prometheus["name"] != "rps" || prometheus["metadata"].(map[string]interface{})["threshold"] != "100" {
+		t.Errorf("prometheus trigger = %v", prometheus)
+	}
+	auth, _ := prometheus["authenticationRef"].(map[string]interface{})
+	if auth["name"] != "prometheus-auth" || auth["kind"] != "ClusterTriggerAuthentication" {
+		t.Errorf("authenticationRef = %v", auth)
+	}
+}
+
+func TestOverrideTriggers(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+	now := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	overrides := []domain.Override{
+		{
+			// Истекло час назад
+			Start:    time.Date(2025, time.March, 10, 8, 0, 0, 0, time.UTC),
+			End:      time.Date(2025, time.March, 10, 11, 0, 0, 0, time.UTC),
+			Replicas: 9,
+		},
+		{
+			Start:    time.Date(2025, time.March, 10, 10, 0, 0, 0, time.UTC),
+			End:      time.Date(2025, time.March, 10, 14, 30, 0, 0, time.UTC),
+			Replicas: 6,
+		},
+		{
+			// После перехода на летнее время, начало уже в апреле по Берлину
+			Start:    time.Date(2025, time.March, 31, 22, 0, 0, 0, time.UTC),
+			End:      time.Date(2025, time.April, 2, 8, 0, 0, 0, time.UTC),
+			Replicas: 4,
+		},
+	}
+
+	want := []string{
+		"00 11 10 3 *|30 15 10 3 *|6",
+		"00 00 1 4 *|00 10 2 4 *|4",
+	}
+	if got := cronWindows(overrideTriggers(overrides, loc, now)); !reflect.DeepEqual(got, want) {
+		t.Errorf("overrideTriggers() =\n%q\nwant\n%q", got, want)
+	}
+}
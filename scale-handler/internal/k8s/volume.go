@@ -0,0 +1,106 @@
+package k8s
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"scale-handler/internal/domain"
+)
+
+func volumesToK8s(volumes []domain.Volume) []corev1.Volume {
+	var result []corev1.Volume
+	for _, v := range volumes {
+		vol := corev1.Volume{Name: v.Name}
+		switch {
+		case v.EmptyDir != nil:
+			vol.EmptyDir = &corev1.EmptyDirVolumeSource{Medium: corev1.StorageMedium(v.EmptyDir.Medium)}
+			if v.EmptyDir.SizeLimit != "" {
+				limit := resource.MustParse(v.EmptyDir.SizeLimit)
+				vol.EmptyDir.SizeLimit = &limit
+			}
+		case v.ConfigMap != nil:
+			vol.ConfigMap = &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: v.ConfigMap.Name},
+				Items:                keyToPathToK8s(v.ConfigMap.Items),
+				DefaultMode:          modePtr(v.ConfigMap.DefaultMode),
+				Optional:             optionalPtr(v.ConfigMap.Optional),
+			}
+		case v.Secret != nil:
+			vol.Secret = &corev1.SecretVolumeSource{
+				SecretName:  v.Secret.Name,
+				Items:       keyToPathToK8s(v.Secret.Items),
+				DefaultMode: modePtr(v.Secret.DefaultMode),
+				Optional:    optionalPtr(v.Secret.Optional),
+			}
+		case v.PersistentVolumeClaim != nil:
+			vol.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: v.PersistentVolumeClaim.ClaimName,
+				ReadOnly:  v.PersistentVolumeClaim.ReadOnly,
+			}
+		case v.Projected != nil:
+			vol.Projected = &corev1.ProjectedVolumeSource{DefaultMode: modePtr(v.Projected.DefaultMode)}
+			for _, s := range v.Projected.Sources {
+				vol.Projected.Sources = append(vol.Projected.Sources, projectionToK8s(s))
+			}
+		}
+		result = append(result, vol)
+	}
+	return result
+}
+
+func projectionToK8s(s domain.VolumeProjection) corev1.VolumeProjection {
+	var p corev1.VolumeProjection
+	if s.ConfigMap != nil {
+		p.ConfigMap = &corev1.ConfigMapProjection{
+			LocalObjectReference: corev1.LocalObjectReference{Name: s.ConfigMap.Name},
+			Items:                keyToPathToK8s(s.ConfigMap.Items),
+			Optional:             optionalPtr(s.ConfigMap.Optional),
+		}
+	}
+	if s.Secret != nil {
+		p.Secret = &corev1.SecretProjection{
+			LocalObjectReference: corev1.LocalObjectReference{Name: s.Secret.Name},
+			Items:                keyToPathToK8s(s.Secret.Items),
+			Optional:             optionalPtr(s.Secret.Optional),
+		}
+	}
+	if t := s.ServiceAccountToken; t != nil {
+		p.ServiceAccountToken = &corev1.ServiceAccountTokenProjection{
+			Audience: t.Audience,
+			Path:     t.Path,
+		}
+		if t.ExpirationSeconds > 0 {
+			p.ServiceAccountToken.ExpirationSeconds = &t.ExpirationSeconds
+		}
+	}
+	return p
+}
+
+func keyToPathToK8s(items []domain.KeyToPath) []corev1.KeyToPath {
+	var result []corev1.KeyToPath
+	for _, item := range items {
+		result = append(result, corev1.KeyToPath{Key: item.Key, Path: item.Path, Mode: modePtr(item.Mode)})
+	}
+	return result
+}
+
+func volumeMountsToK8s(mounts []domain.VolumeMount) []corev1.VolumeMount {
+	var result []corev1.VolumeMount
+	for _, m := range mounts {
+		result = append(result, corev1.VolumeMount{
+			Name:      m.Name,
+			MountPath: m.MountPath,
+			SubPath:   m.SubPath,
+			ReadOnly:  m.ReadOnly,
+		})
+	}
+	return result
+}
+
+// modePtr оставляет права по умолчанию (0644), если они не заданы
+func modePtr(mode int32) *int32 {
+	if mode == 0 {
+		return nil
+	}
+	return &mode
+}
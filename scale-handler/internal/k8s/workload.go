@@ -0,0 +1,208 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"scale-handler/internal/domain"
+)
+
+func statefulSetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group:    "apps",
+		Version:  "v1",
+		Resource: "statefulsets",
+	}
+}
+
+func serviceGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Version:  "v1",
+		Resource: "services",
+	}
+}
+
+// serviceName - имя Service для расписания. Имя Service должно быть меткой
+// DNS-1035 и начинаться с буквы, а UUID часто начинается с цифры.
+func serviceName(scheduleID string) string {
+	return "cs-" + scheduleID
+}
+
+// headlessServiceName - имя headless Service, который нужен StatefulSet
+func headlessServiceName(scheduleID string) string {
+	return serviceName(scheduleID) + "-headless"
+}
+
+// applyWorkload создаёт workload с начальным числом реплик, а существующий
+// обновляет через server-side apply без spec.replicas: реплики задаёт KEDA,
+// и правка расписания не должна их сбрасывать
+func (r *Reconciler) applyWorkload(ctx context.Context, meta metav1.ObjectMeta, app *domain.Application, replicas int32) error {
+	ns, name, kind := meta.Namespace, meta.Name, app.WorkloadKind()
+	if kind == domain.KindStatefulSet {
+		if err := r.applyHeadlessService(ctx, meta, app); err != nil {
+			return err
+		}
+	}
+
+	workload, gvr, err := r.buildWorkload(meta, app, replicas)
+	if err != nil {
+		return err
+	}
+	obj, err := toApplyObject(workload)
+	if err != nil {
+		return err
+	}
+
+	client := r.dynamic.Resource(gvr).Namespace(ns)
+	_, err = client.Get(ctx, name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = client.Create(ctx, obj, metav1.CreateOptions{FieldManager: fieldManager})
+		if err == nil {
+			r.logger.Info("Created "+kind, "name", name, "namespace", ns)
+			return nil
+		}
+		if !errors.IsAlreadyExists(err) {
+			return fmt.Errorf("create %s: %w", strings.ToLower(kind), err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("get %s: %w", strings.ToLower(kind), err)
+	}
+
+	unstructured.RemoveNestedField(obj.Object, "spec", "replicas")
+	if _, err := r.apply(ctx, gvr, obj); err != nil {
+		return fmt.Errorf("apply %s: %w", strings.ToLower(kind), err)
+	}
+	r.logger.Info("Applied "+kind, "name", name, "namespace", ns)
+	return nil
+}
+
+func (r *Reconciler) applyHeadlessService(ctx context.Context, meta metav1.ObjectMeta, app *domain.Application) error {
+	obj, err := toApplyObject(r.buildHeadlessService(meta, app))
+	if err != nil {
+		return err
+	}
+	if _, err := r.apply(ctx, serviceGVR(), obj); err != nil {
+		return fmt.Errorf("apply headless service: %w", err)
+	}
+	return nil
+}
+
+// deleteWorkload удаляет workload, созданный из Application
+func (r *Reconciler) deleteWorkload(ctx context.Context, ns, name string, app *domain.Application) error {
+	r.deleteNetwork(ctx, ns, name)
+	if app.WorkloadKind() == domain.KindStatefulSet {
+		err := r.dynamic.Resource(serviceGVR()).Namespace(ns).Delete(ctx, headlessServiceName(name), metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			r.logger.Error("Failed to delete headless Service", "id", name, "error", err)
+		}
+		return r.dynamic.Resource(statefulSetGVR()).Namespace(ns).Delete(ctx, name, metav1.DeleteOptions{})
+	}
+	return r.dynamic.Resource(deploymentGVR()).Namespace(ns).Delete(ctx, name, metav1.DeleteOptions{})
+}
+
+// buildWorkload строит Deployment или StatefulSet с начальным числом реплик;
+// дальше реплики задаёт KEDA
+func (r *Renderer) buildWorkload(meta metav1.ObjectMeta, app *domain.Application, replicas int32) (runtime.Object, schema.GroupVersionResource, error) {
+	selector := &metav1.LabelSelector{
+		MatchLabels: map[string]string{"app": meta.Name},
+	}
+	template, err := r.buildPodTemplate(meta, app)
+	if err != nil {
+		return nil, schema.GroupVersionResource{}, err
+	}
+
+	if app.WorkloadKind() == domain.KindStatefulSet {
+		return &appsv1.StatefulSet{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "apps/v1",
+				Kind:       domain.KindStatefulSet,
+			},
+			ObjectMeta: meta,
+			Spec: appsv1.StatefulSetSpec{
+				Replicas:    int32Ptr(replicas),
+				ServiceName: headlessServiceName(meta.Name),
+				Selector:    selector,
+				Template:    template,
+			},
+		}, statefulSetGVR(), nil
+	}
+
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "apps/v1",
+			Kind:       domain.KindDeployment,
+		},
+		ObjectMeta: meta,
+		Spec: appsv1.DeploymentSpec{
+			Replicas: int32Ptr(replicas),
+			Selector: selector,
+			Template: template,
+		},
+	}, deploymentGVR(), nil
+}
+
+func (r *Renderer) buildPodTemplate(meta metav1.ObjectMeta, app *domain.Application) (corev1.PodTemplateSpec, error) {
+	containers := make([]corev1.Container, len(app.Containers))
+	for i, c := range app.Containers {
+		cont, err := r.containerToK8s(c)
+		if err != nil {
+			return corev1.PodTemplateSpec{}, fmt.Errorf("container %s: %w", c.Name, err)
+		}
+		containers[i] = cont
+	}
+
+	var initContainers []corev1.Container
+	for _, c := range app.InitContainers {
+		cont, err := r.containerToK8s(c)
+		if err != nil {
+			return corev1.PodTemplateSpec{}, fmt.Errorf("init container %s: %w", c.Name, err)
+		}
+		initContainers = append(initContainers, cont)
+	}
+
+	spec := corev1.PodSpec{
+		InitContainers:                initContainers,
+		Containers:                    containers,
+		Volumes:                       volumesToK8s(app.Volumes),
+		ImagePullSecrets:              imagePullSecretsToK8s(app.ImagePullSecrets),
+		ServiceAccountName:            app.ServiceAccountName,
+		TerminationGracePeriodSeconds: app.TerminationGracePeriodSeconds,
+		SecurityContext:               podSecurityContextToK8s(app.SecurityContext),
+	}
+	applyPlacement(&spec, meta.Name, app)
+
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: podLabels(meta),
+		},
+		Spec: spec,
+	}, nil
+}
+
+// buildHeadlessService строит Service без ClusterIP, который даёт подам
+// StatefulSet стабильные DNS-имена
+func (r *Renderer) buildHeadlessService(meta metav1.ObjectMeta, app *domain.Application) *corev1.Service {
+	name := meta.Name
+	meta.Name = headlessServiceName(name)
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
+		ObjectMeta: meta,
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  map[string]string{"app": name},
+			Ports:     containerServicePorts(app),
+		},
+	}
+}
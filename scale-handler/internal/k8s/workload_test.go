@@ -0,0 +1,122 @@
+package k8s
+
+import (
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"scale-handler/internal/domain"
+)
+
+func TestHeadlessServiceName(t *testing.T) {
+	r := &Renderer{}
+	// UUID с цифрой в начале не годится в имя Service
+	id := "0b9f4c1e-2d3a-4f5b-8c6d-7e8f9a0b1c2d"
+	app := &domain.Application{
+		Kind:       domain.KindStatefulSet,
+		Containers: []domain.Container{{Name: "db", Image: "postgres:16", Ports: []domain.ContainerPort{{ContainerPort: 5432}}}},
+	}
+
+	meta := r.objectMeta(&domain.Schedule{ID: id, Namespace: "default"})
+
+	service := r.buildHeadlessService(meta, app)
+	if errs := validation.IsDNS1035Label(service.Name); len(errs) > 0 {
+		t.Errorf("headless Service name %q: %v", service.Name, errs)
+	}
+
+	workload, gvr, err := r.buildWorkload(meta, app, 1)
+	if err != nil {
+		t.Fatalf("buildWorkload() error = %v", err)
+	}
+	statefulSet, ok := workload.(*appsv1.StatefulSet)
+	if !ok || gvr != statefulSetGVR() {
+		t.Fatalf("buildWorkload() = %T, %v, want StatefulSet", workload, gvr)
+	}
+	if statefulSet.Spec.ServiceName != service.Name {
+		t.Errorf("StatefulSet serviceName = %q, want %q", statefulSet.Spec.ServiceName, service.Name)
+	}
+}
+
+func TestBuildWorkloadResources(t *testing.T) {
+	r := &Renderer{}
+	meta := metav1.ObjectMeta{Name: "web", Namespace: "default"}
+	app := func(res *domain.Resources) *domain.Application {
+		return &domain.Application{Containers: []domain.Container{{Name: "web", Image: "nginx", Resources: res}}}
+	}
+
+	workload, gvr, err := r.buildWorkload(meta, app(&domain.Resources{
+		Requests: &domain.ResourceQuantity{CPU: "250m", Memory: "128Mi"},
+	}), 1)
+	if err != nil {
+		t.Fatalf("buildWorkload() error = %v", err)
+	}
+	deployment, ok := workload.(*appsv1.Deployment)
+	if !ok || gvr != deploymentGVR() {
+		t.Fatalf("buildWorkload() = %T, %v, want Deployment", workload, gvr)
+	}
+	requests := deployment.Spec.Template.Spec.Containers[0].Resources.Requests
+	if cpu := requests[corev1.ResourceCPU]; cpu.String() != "250m" {
+		t.Errorf("cpu request = %s, want 250m", cpu.String())
+	}
+	if memory := requests[corev1.ResourceMemory]; memory.String() != "128Mi" {
+		t.Errorf("memory request = %s, want 128Mi", memory.String())
+	}
+
+	// Некорректная quantity в сохранённом расписании - ошибка, а не panic
+	if _, _, err := r.buildWorkload(meta, app(&domain.Resources{
+		Limits: &domain.ResourceQuantity{Memory: "1 GB"},
+	}), 1); err == nil {
+		t.Error("buildWorkload() error = nil, want error for invalid quantity")
+	}
+}
+
+func TestBuildPodTemplateRuntime(t *testing.T) {
+	r := &Renderer{}
+	grace := int64(60)
+	app := &domain.Application{
+		InitContainers: []domain.Container{{Name: "migrate", Image: "app", Command: []string{"/migrate"}}},
+		Containers: []domain.Container{{
+			Name:            "web",
+			Image:           "registry.example.com/app",
+			Args:            []string{"--port=8080"},
+			ImagePullPolicy: "Always",
+			Lifecycle: &domain.Lifecycle{
+				PreStop: &domain.LifecycleHandler{Exec: &domain.ExecAction{Command: []string{"sleep", "5"}}},
+			},
+		}},
+		ImagePullSecrets:              []string{"registry"},
+		ServiceAccountName:            "web",
+		TerminationGracePeriodSeconds: &grace,
+	}
+
+	template, err := r.buildPodTemplate(metav1.ObjectMeta{Name: "web"}, app)
+	if err != nil {
+		t.Fatalf("buildPodTemplate() error = %v", err)
+	}
+	spec := template.Spec
+	if len(spec.InitContainers) != 1 || spec.InitContainers[0].Command[0] != "/migrate" {
+		t.Errorf("initContainers = %+v", spec.InitContainers)
+	}
+	web := spec.Containers[0]
+	if web.ImagePullPolicy != corev1.PullAlways || len(web.Args) != 1 {
+		t.Errorf("container = %+v", web)
+	}
+	if web.Lifecycle == nil || web.Lifecycle.PreStop == nil || web.Lifecycle.PreStop.Exec == nil || web.Lifecycle.PostStart != nil {
+		t.Errorf("lifecycle = %+v", web.Lifecycle)
+	}
+	if len(spec.ImagePullSecrets) != 1 || spec.ImagePullSecrets[0].Name != "registry" {
+		t.Errorf("imagePullSecrets = %+v", spec.ImagePullSecrets)
+	}
+	if spec.ServiceAccountName != "web" || spec.TerminationGracePeriodSeconds == nil || *spec.TerminationGracePeriodSeconds != 60 {
+		t.Errorf("serviceAccountName = %q, terminationGracePeriodSeconds = %v", spec.ServiceAccountName, spec.TerminationGracePeriodSeconds)
+	}
+
+	// Quantity init-контейнера разбирается так же, как у основных
+	app.InitContainers[0].Resources = &domain.Resources{Requests: &domain.ResourceQuantity{CPU: "half"}}
+	if _, err := r.buildPodTemplate(metav1.ObjectMeta{Name: "web"}, app); err == nil {
+		t.Error("buildPodTemplate() error = nil, want error for invalid init container quantity")
+	}
+}
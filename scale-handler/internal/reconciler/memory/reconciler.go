@@ -0,0 +1,285 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"scale-handler/internal/config"
+	"scale-handler/internal/domain"
+	"scale-handler/internal/k8s"
+	"scale-handler/internal/reconciler"
+)
+
+var (
+	_ reconciler.Reconciler       = (*Reconciler)(nil)
+	_ reconciler.GarbageCollector = (*Reconciler)(nil)
+)
+
+// Reconciler хранит применённые объекты расписаний в памяти вместо кластера.
+// Объекты строятся так же, как в KEDA-бэкенде, поэтому результат можно
+// проверять в тестах и локальных демо без кластера. Масштабирование не
+// моделируется: workload держит начальное число реплик или реплики паузы.
+type Reconciler struct {
+	mu         sync.Mutex
+	renderer   *k8s.Renderer
+	objects    map[string][]domain.Manifest // по ID расписания
+	replicas   map[string]int32             // реплики workload по ID расписания
+	orphanedAt map[objectKey]time.Time
+	logger     *slog.Logger
+}
+
+type objectKey struct {
+	kind, namespace, name string
+}
+
+func keyOf(obj domain.Manifest) objectKey {
+	return objectKey{kind: obj.Kind, namespace: obj.Namespace, name: obj.Name}
+}
+
+func NewReconciler(cfg config.K8sConfig, logger *slog.Logger) (*Reconciler, error) {
+	renderer, err := k8s.NewRenderer(cfg, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reconciler{
+		renderer:   renderer,
+		objects:    map[string][]domain.Manifest{},
+		replicas:   map[string]int32{},
+		orphanedAt: map[objectKey]time.Time{},
+		logger:     logger,
+	}, nil
+}
+
+// Objects возвращает копии объектов, применённых для расписания
+func (r *Reconciler) Objects(scheduleID string) []domain.Manifest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return cloneManifests(r.objects[scheduleID])
+}
+
+func cloneManifests(objects []domain.Manifest) []domain.Manifest {
+	result := make([]domain.Manifest, len(objects))
+	for i, obj := range objects {
+		result[i] = obj
+		result[i].Content = bytes.Clone(obj.Content)
+	}
+	return result
+}
+
+func (r *Reconciler) CreateResources(ctx context.Context, schedule *domain.Schedule) error {
+	return r.apply(schedule)
+}
+
+func (r *Reconciler) UpdateResources(ctx context.Context, schedule *domain.Schedule) error {
+	return r.apply(schedule)
+}
+
+func (r *Reconciler) apply(schedule *domain.Schedule) error {
+	objects, err := r.Render(schedule)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.store(schedule, objects)
+	r.logger.Info("Schedule applied in memory", "id", schedule.ID, "objects", len(objects))
+	return nil
+}
+
+// store заменяет объекты расписания и обновляет реплики workload.
+// Вызывается под r.mu.
+func (r *Reconciler) store(schedule *domain.Schedule, objects []domain.Manifest) {
+	r.dropLocked(schedule.ID, objects)
+	if len(objects) == 0 {
+		return
+	}
+
+	r.objects[schedule.ID] = cloneManifests(objects)
+
+	if _, ok := r.replicas[schedule.ID]; !ok && schedule.Target == nil {
+		r.replicas[schedule.ID] = schedule.Rules.DefaultReplicas
+	}
+	if schedule.Pause != nil {
+		r.replicas[schedule.ID] = schedule.Pause.Replicas
+	}
+}
+
+// dropLocked удаляет объекты расписания, которых нет среди keep.
+// Вызывается под r.mu.
+func (r *Reconciler) dropLocked(scheduleID string, keep []domain.Manifest) {
+	kept := make(map[objectKey]bool, len(keep))
+	for _, obj := range keep {
+		kept[keyOf(obj)] = true
+	}
+	for _, obj := range r.objects[scheduleID] {
+		if !kept[keyOf(obj)] {
+			delete(r.orphanedAt, keyOf(obj))
+		}
+	}
+	if len(keep) == 0 {
+		delete(r.objects, scheduleID)
+		delete(r.replicas, scheduleID)
+	}
+}
+
+func (r *Reconciler) DeleteResources(ctx context.Context, schedule *domain.Schedule) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.dropLocked(schedule.ID, nil)
+	return nil
+}
+
+// SyncResources сравнивает сохранённые объекты с расписанием и заменяет их.
+// Если расписание больше не порождает объектов, сохранённые удаляются.
+func (r *Reconciler) SyncResources(ctx context.Context, schedule *domain.Schedule) ([]domain.ResourceChange, error) {
+	objects, err := r.Render(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	current := make(map[objectKey]domain.Manifest, len(r.objects[schedule.ID]))
+	for _, obj := range r.objects[schedule.ID] {
+		current[keyOf(obj)] = obj
+	}
+
+	var changes []domain.ResourceChange
+	record := func(obj domain.Manifest, action string) {
+		changes = append(changes, domain.ResourceChange{
+			ScheduleID: schedule.ID,
+			Kind:       obj.Kind,
+			Namespace:  obj.Namespace,
+			Name:       obj.Name,
+			Action:     action,
+		})
+	}
+	for _, obj := range objects {
+		existing, ok := current[keyOf(obj)]
+		switch {
+		case !ok:
+			record(obj, domain.ActionCreated)
+		case !bytes.Equal(existing.Content, obj.Content):
+			record(obj, domain.ActionUpdated)
+		}
+		delete(current, keyOf(obj))
+	}
+	for _, obj := range current {
+		record(obj, domain.ActionDeleted)
+	}
+
+	r.store(schedule, objects)
+	return changes, nil
+}
+
+// ValidateReferences ничего не проверяет: внешних объектов в памяти нет
+func (r *Reconciler) ValidateReferences(ctx context.Context, schedule *domain.Schedule) error {
+	return nil
+}
+
+func (r *Reconciler) CurrentReplicas(ctx context.Context, schedule *domain.Schedule) (int32, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	replicas, ok := r.replicas[schedule.ID]
+	if !ok {
+		return 0, fmt.Errorf("workload of schedule %s not found, replicas must be given explicitly: %w", schedule.ID, domain.ErrInvalidArgument)
+	}
+	return replicas, nil
+}
+
+// Render строит объекты так же, как KEDA-бэкенд. JSON объекта сериализуется
+// с отсортированными ключами, поэтому сверка сравнивает его побайтно.
+func (r *Reconciler) Render(schedule *domain.Schedule) ([]domain.Manifest, error) {
+	objects, err := r.renderer.Render(schedule)
+	if err != nil {
+		return nil, fmt.Errorf("render objects: %w", err)
+	}
+	return objects, nil
+}
+
+// DryRun совпадает с Render: сервера, который проверил бы объекты, нет
+func (r *Reconciler) DryRun(ctx context.Context, schedule *domain.Schedule) ([]domain.Manifest, error) {
+	return r.Render(schedule)
+}
+
+func (r *Reconciler) ListManaged(ctx context.Context) ([]domain.ManagedResource, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var result []domain.ManagedResource
+	for id, objects := range r.objects {
+		for _, obj := range objects {
+			result = append(result, domain.ManagedResource{
+				ScheduleID: id,
+				Kind:       obj.Kind,
+				Namespace:  obj.Namespace,
+				Name:       obj.Name,
+				OrphanedAt: r.orphanedAt[keyOf(obj)],
+			})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].ScheduleID != result[j].ScheduleID {
+			return result[i].ScheduleID < result[j].ScheduleID
+		}
+		return result[i].Kind < result[j].Kind
+	})
+	return result, nil
+}
+
+// MarkOrphaned ставит отметку об осиротевшем объекте, at == nil снимает её
+func (r *Reconciler) MarkOrphaned(ctx context.Context, res domain.ManagedResource, at *time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := objectKey{kind: res.Kind, namespace: res.Namespace, name: res.Name}
+	if r.find(res.ScheduleID, key) < 0 {
+		return fmt.Errorf("%s %s/%s: %w", res.Kind, res.Namespace, res.Name, domain.ErrNotFound)
+	}
+	if at == nil {
+		delete(r.orphanedAt, key)
+	} else {
+		r.orphanedAt[key] = *at
+	}
+	return nil
+}
+
+func (r *Reconciler) DeleteOrphaned(ctx context.Context, res domain.ManagedResource) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := objectKey{kind: res.Kind, namespace: res.Namespace, name: res.Name}
+	i := r.find(res.ScheduleID, key)
+	if i < 0 {
+		return nil
+	}
+	rest := slices.Delete(slices.Clone(r.objects[res.ScheduleID]), i, i+1)
+	r.dropLocked(res.ScheduleID, rest)
+	if len(rest) > 0 {
+		r.objects[res.ScheduleID] = rest
+	}
+	return nil
+}
+
+// find возвращает индекс объекта расписания или -1. Вызывается под r.mu.
+func (r *Reconciler) find(scheduleID string, key objectKey) int {
+	for i, obj := range r.objects[scheduleID] {
+		if keyOf(obj) == key {
+			return i
+		}
+	}
+	return -1
+}
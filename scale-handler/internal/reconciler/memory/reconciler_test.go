@@ -0,0 +1,58 @@
+package memory
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"scale-handler/internal/config"
+	"scale-handler/internal/domain"
+)
+
+func TestSyncResources(t *testing.T) {
+	ctx := context.Background()
+	backend, err := NewReconciler(config.K8sConfig{DefaultTimezone: "UTC", DefaultNamespace: "default"}, slog.Default())
+	if err != nil {
+		t.Fatal(err)
+	}
+	schedule := &domain.Schedule{
+		ID:          "a",
+		Rules:       domain.ScheduleRules{DefaultReplicas: 1},
+		Application: &domain.Application{Containers: []domain.Container{{Name: "web", Image: "nginx"}}},
+	}
+	if err := backend.CreateResources(ctx, schedule); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(backend.Objects("a")); got != 2 {
+		t.Fatalf("Objects() after create = %d, want 2", got)
+	}
+
+	changes, err := backend.SyncResources(ctx, schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("SyncResources() of unchanged schedule = %v, want no changes", changes)
+	}
+
+	// Расписание без контейнеров не порождает объектов: сохранённые удаляются
+	schedule.Application = &domain.Application{}
+	changes, err = backend.SyncResources(ctx, schedule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 2 {
+		t.Errorf("SyncResources() returned %d changes, want 2", len(changes))
+	}
+	for _, c := range changes {
+		if c.Action != domain.ActionDeleted {
+			t.Errorf("%s %s action = %s, want %s", c.Kind, c.Name, c.Action, domain.ActionDeleted)
+		}
+	}
+	if got := backend.Objects("a"); len(got) != 0 {
+		t.Errorf("Objects() after sync = %d, want none", len(got))
+	}
+	if _, err := backend.CurrentReplicas(ctx, schedule); err == nil {
+		t.Error("CurrentReplicas() after sync: want error")
+	}
+}
@@ -0,0 +1,32 @@
+package reconciler
+
+import (
+	"context"
+	"time"
+
+	"scale-handler/internal/domain"
+)
+
+// Reconciler - бэкенд масштабирования: применяет расписания и сверяет
+// применённое состояние. Основная реализация - KEDA в кластере (пакет k8s),
+// для тестов и локальных демо есть хранение в памяти (пакет memory).
+type Reconciler interface {
+	CreateResources(ctx context.Context, schedule *domain.Schedule) error
+	UpdateResources(ctx context.Context, schedule *domain.Schedule) error
+	DeleteResources(ctx context.Context, schedule *domain.Schedule) error
+	SyncResources(ctx context.Context, schedule *domain.Schedule) ([]domain.ResourceChange, error)
+	ValidateReferences(ctx context.Context, schedule *domain.Schedule) error
+	CurrentReplicas(ctx context.Context, schedule *domain.Schedule) (int32, error)
+
+	Render(schedule *domain.Schedule) ([]domain.Manifest, error)
+	DryRun(ctx context.Context, schedule *domain.Schedule) ([]domain.Manifest, error)
+}
+
+// GarbageCollector - необязательная часть бэкенда: перечисление и удаление
+// объектов, для которых больше нет расписания. Бэкенд без неё работает без
+// сборки мусора.
+type GarbageCollector interface {
+	ListManaged(ctx context.Context) ([]domain.ManagedResource, error)
+	MarkOrphaned(ctx context.Context, res domain.ManagedResource, at *time.Time) error
+	DeleteOrphaned(ctx context.Context, res domain.ManagedResource) error
+}
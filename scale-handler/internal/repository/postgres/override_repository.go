@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"scale-handler/internal/domain"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type OverrideRepository struct {
+	db     *sqlx.DB
+	logger *slog.Logger
+}
+
+func NewOverrideRepository(db *sqlx.DB, logger *slog.Logger) *OverrideRepository {
+	return &OverrideRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const overrideColumns = `id, schedule_id, replicas, starts_at, ends_at, reason, created_at`
+
+func scanOverride(row rowScanner) (domain.Override, error) {
+	var o domain.Override
+	err := row.Scan(&o.ID, &o.ScheduleID, &o.Replicas, &o.Start, &o.End, &o.Reason, &o.CreatedAt)
+	return o, err
+}
+
+func (r *OverrideRepository) Create(ctx context.Context, override *domain.Override) (*domain.Override, error) {
+	query := `
+		INSERT INTO schedule_overrides (schedule_id, replicas, starts_at, ends_at, reason)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING ` + overrideColumns
+
+	created, err := scanOverride(r.db.QueryRowContext(ctx, query,
+		override.ScheduleID, override.Replicas, override.Start, override.End, override.Reason))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create override: %w", err)
+	}
+
+	return &created, nil
+}
+
+// ListBySchedule возвращает все переопределения расписания, включая истёкшие
+func (r *OverrideRepository) ListBySchedule(ctx context.Context, scheduleID string) ([]domain.Override, error) {
+	query := `
+		SELECT ` + overrideColumns + `
+		FROM schedule_overrides
+		WHERE schedule_id = $1
+		ORDER BY starts_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, scheduleID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overrides: %w", err)
+	}
+	defer rows.Close()
+
+	var overrides []domain.Override
+	for rows.Next() {
+		o, err := scanOverride(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan override: %w", err)
+		}
+		overrides = append(overrides, o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return overrides, nil
+}
+
+func (r *OverrideRepository) Delete(ctx context.Context, scheduleID, id string) error {
+	query := `
+		DELETE FROM schedule_overrides
+		WHERE id = $1 AND schedule_id = $2
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, scheduleID)
+	if err != nil {
+		return fmt.Errorf("failed to delete override: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("override not found: %w", domain.ErrNotFound)
+	}
+
+	return nil
+}
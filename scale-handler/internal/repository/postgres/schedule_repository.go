@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"scale-handler/internal/domain"
 
@@ -49,36 +50,59 @@ func NewScheduleRepository(db *sqlx.DB, logger *slog.Logger) *ScheduleRepository
 	}
 }
 
-func (r *ScheduleRepository) Create(ctx context.Context, rules domain.ScheduleRules, application *domain.Application) (*domain.Schedule, error) {
-	query := `
-		INSERT INTO public.schedules (rules, application)
-		VALUES ($1::jsonb, $2::jsonb)
-		RETURNING id, rules, application, created_at, updated_at
-	`
-
-	rulesJSON, err := json.Marshal(rules)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal rules: %w", err)
-	}
+// Строки, сохранённые до появления namespace, читаются с пустым namespace:
+// значение по умолчанию подставляет usecase из настроек.
+const scheduleColumns = `id, COALESCE(namespace, ''), rules, application, target, metadata, generation,
+	status_phase, last_applied_at, last_error, applied_generation,
+	paused_at, paused_replicas, paused_by, pause_reason, ` + activeOverridesColumn + `, created_at, updated_at`
+
+// activeOverridesColumn - ещё не истёкшие переопределения расписания. Они
+// читаются вместе с расписанием, чтобы любой путь применения их учитывал.
+const activeOverridesColumn = `COALESCE((
+		SELECT jsonb_agg(jsonb_build_object(
+			'id', o.id, 'scheduleId', o.schedule_id, 'replicas', o.replicas, 'start', o.starts_at,
+			'end', o.ends_at, 'reason', o.reason, 'createdAt', o.created_at) ORDER BY o.starts_at)
+		FROM schedule_overrides o
+		WHERE o.schedule_id = schedules.id AND o.ends_at > CURRENT_TIMESTAMP
+	), '[]'::jsonb)`
+
+// scheduleMetadata - содержимое колонки metadata
+type scheduleMetadata struct {
+	Labels      map[string]string `json:"labels,omitempty"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
 
-	var appArg interface{}
-	if application != nil {
-		b, _ := json.Marshal(application)
-		appArg = string(b)
-	}
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
 
+func scanSchedule(row rowScanner) (*domain.Schedule, error) {
 	var schedule domain.Schedule
-	var rulesBytes, appBytes []byte
+	var rulesBytes, appBytes, targetBytes, metadataBytes, overridesBytes []byte
+	var pausedAt *time.Time
+	var pause domain.PauseState
 
-	err = r.db.QueryRowContext(ctx, query, string(rulesJSON), appArg).Scan(
+	if err := row.Scan(
 		&schedule.ID,
+		&schedule.Namespace,
 		&rulesBytes,
 		&appBytes,
+		&targetBytes,
+		&metadataBytes,
+		&schedule.Generation,
+		&schedule.Status.Phase,
+		&schedule.Status.LastAppliedAt,
+		&schedule.Status.LastError,
+		&schedule.Status.AppliedGeneration,
+		&pausedAt,
+		&pause.Replicas,
+		&pause.PausedBy,
+		&pause.Reason,
+		&overridesBytes,
 		&schedule.CreatedAt,
 		&schedule.UpdatedAt,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	); err != nil {
+		return nil, err
 	}
 
 	if err := json.Unmarshal(rulesBytes, &schedule.Rules); err != nil {
@@ -89,27 +113,86 @@ func (r *ScheduleRepository) Create(ctx context.Context, rules domain.ScheduleRu
 			return nil, fmt.Errorf("failed to unmarshal application: %w", err)
 		}
 	}
+	if len(targetBytes) > 0 {
+		if err := json.Unmarshal(targetBytes, &schedule.Target); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal target: %w", err)
+		}
+	}
+	var metadata scheduleMetadata
+	if err := json.Unmarshal(metadataBytes, &metadata); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+	schedule.Labels, schedule.Annotations = metadata.Labels, metadata.Annotations
+	if pausedAt != nil {
+		pause.PausedAt = *pausedAt
+		schedule.Pause = &pause
+	}
+	if err := json.Unmarshal(overridesBytes, &schedule.Overrides); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal overrides: %w", err)
+	}
 
 	return &schedule, nil
 }
 
+func marshalSchedule(schedule *domain.Schedule) (string, interface{}, interface{}, string, error) {
+	rulesJSON, err := json.Marshal(schedule.Rules)
+	if err != nil {
+		return "", nil, nil, "", fmt.Errorf("failed to marshal rules: %w", err)
+	}
+
+	var appArg interface{}
+	if schedule.Application != nil {
+		b, err := json.Marshal(schedule.Application)
+		if err != nil {
+			return "", nil, nil, "", fmt.Errorf("failed to marshal application: %w", err)
+		}
+		appArg = string(b)
+	}
+
+	var targetArg interface{}
+	if schedule.Target != nil {
+		b, err := json.Marshal(schedule.Target)
+		if err != nil {
+			return "", nil, nil, "", fmt.Errorf("failed to marshal target: %w", err)
+		}
+		targetArg = string(b)
+	}
+
+	metadataJSON, err := json.Marshal(scheduleMetadata{Labels: schedule.Labels, Annotations: schedule.Annotations})
+	if err != nil {
+		return "", nil, nil, "", fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	return string(rulesJSON), appArg, targetArg, string(metadataJSON), nil
+}
+
+func (r *ScheduleRepository) Create(ctx context.Context, schedule *domain.Schedule) (*domain.Schedule, error) {
+	query := `
+		INSERT INTO public.schedules (namespace, rules, application, target, metadata)
+		VALUES ($1, $2::jsonb, $3::jsonb, $4::jsonb, $5::jsonb)
+		RETURNING ` + scheduleColumns
+
+	rulesArg, appArg, targetArg, metadataArg, err := marshalSchedule(schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := scanSchedule(r.db.QueryRowContext(ctx, query, schedule.Namespace, rulesArg, appArg, targetArg, metadataArg))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	return created, nil
+}
+
 func (r *ScheduleRepository) GetByID(ctx context.Context, id string) (*domain.Schedule, error) {
 	query := `
-		SELECT id, rules, application, created_at, updated_at
+		SELECT ` + scheduleColumns + `
 		FROM schedules
 		WHERE id = $1
 	`
 
-	var schedule domain.Schedule
-	var rulesBytes, appBytes []byte
-
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&schedule.ID,
-		&rulesBytes,
-		&appBytes,
-		&schedule.CreatedAt,
-		&schedule.UpdatedAt,
-	)
+	schedule, err := scanSchedule(r.db.QueryRowContext(ctx, query, id))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("schedule not found: %w", domain.ErrNotFound)
@@ -117,21 +200,12 @@ func (r *ScheduleRepository) GetByID(ctx context.Context, id string) (*domain.Sc
 		return nil, fmt.Errorf("failed to get schedule: %w", err)
 	}
 
-	if err := json.Unmarshal(rulesBytes, &schedule.Rules); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal rules: %w", err)
-	}
-	if len(appBytes) > 0 {
-		if err := json.Unmarshal(appBytes, &schedule.Application); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal application: %w", err)
-		}
-	}
-
-	return &schedule, nil
+	return schedule, nil
 }
 
 func (r *ScheduleRepository) List(ctx context.Context) ([]*domain.Schedule, error) {
 	query := `
-		SELECT id, rules, application, created_at, updated_at
+		SELECT ` + scheduleColumns + `
 		FROM schedules
 		ORDER BY created_at DESC
 	`
@@ -145,29 +219,11 @@ func (r *ScheduleRepository) List(ctx context.Context) ([]*domain.Schedule, erro
 	var schedules []*domain.Schedule
 
 	for rows.Next() {
-		var schedule domain.Schedule
-		var rulesBytes, appBytes []byte
-
-		if err := rows.Scan(
-			&schedule.ID,
-			&rulesBytes,
-			&appBytes,
-			&schedule.CreatedAt,
-			&schedule.UpdatedAt,
-		); err != nil {
+		schedule, err := scanSchedule(rows)
+		if err != nil {
 			return nil, fmt.Errorf("failed to scan schedule: %w", err)
 		}
-
-		if err := json.Unmarshal(rulesBytes, &schedule.Rules); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal rules: %w", err)
-		}
-		if len(appBytes) > 0 {
-			if err := json.Unmarshal(appBytes, &schedule.Application); err != nil {
-				return nil, fmt.Errorf("failed to unmarshal application: %w", err)
-			}
-		}
-
-		schedules = append(schedules, &schedule)
+		schedules = append(schedules, schedule)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -177,35 +233,20 @@ func (r *ScheduleRepository) List(ctx context.Context) ([]*domain.Schedule, erro
 	return schedules, nil
 }
 
-func (r *ScheduleRepository) Update(ctx context.Context, id string, rules domain.ScheduleRules, application *domain.Application) (*domain.Schedule, error) {
+func (r *ScheduleRepository) Update(ctx context.Context, schedule *domain.Schedule) (*domain.Schedule, error) {
 	query := `
 		UPDATE schedules
-		SET rules = $1, application = $2, updated_at = CURRENT_TIMESTAMP
-		WHERE id = $3
-		RETURNING id, rules, application, created_at, updated_at
-	`
+		SET namespace = $1, rules = $2::jsonb, application = $3::jsonb, target = $4::jsonb, metadata = $5::jsonb,
+			generation = generation + 1, status_phase = 'Pending', updated_at = CURRENT_TIMESTAMP
+		WHERE id = $6
+		RETURNING ` + scheduleColumns
 
-	rulesJSON, err := json.Marshal(rules)
+	rulesArg, appArg, targetArg, metadataArg, err := marshalSchedule(schedule)
 	if err != nil {
-		return nil, fmt.Errorf("failed to marshal rules: %w", err)
+		return nil, err
 	}
 
-	var appArg interface{}
-	if application != nil {
-		b, _ := json.Marshal(application)
-		appArg = string(b)
-	}
-
-	var schedule domain.Schedule
-	var rulesBytes, appBytes []byte
-
-	err = r.db.QueryRowContext(ctx, query, rulesJSON, appArg, id).Scan(
-		&schedule.ID,
-		&rulesBytes,
-		&appBytes,
-		&schedule.CreatedAt,
-		&schedule.UpdatedAt,
-	)
+	updated, err := scanSchedule(r.db.QueryRowContext(ctx, query, schedule.Namespace, rulesArg, appArg, targetArg, metadataArg, schedule.ID))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, fmt.Errorf("schedule not found: %w", domain.ErrNotFound)
@@ -213,16 +254,7 @@ func (r *ScheduleRepository) Update(ctx context.Context, id string, rules domain
 		return nil, fmt.Errorf("failed to update schedule: %w", err)
 	}
 
-	if err := json.Unmarshal(rulesBytes, &schedule.Rules); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal rules: %w", err)
-	}
-	if len(appBytes) > 0 {
-		if err := json.Unmarshal(appBytes, &schedule.Application); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal application: %w", err)
-		}
-	}
-
-	return &schedule, nil
+	return updated, nil
 }
 
 func (r *ScheduleRepository) Delete(ctx context.Context, id string) error {
@@ -247,3 +279,55 @@ func (r *ScheduleRepository) Delete(ctx context.Context, id string) error {
 
 	return nil
 }
+
+func (r *ScheduleRepository) UpdateStatus(ctx context.Context, id string, status domain.ScheduleStatus) error {
+	query := `
+		UPDATE schedules
+		SET status_phase = $1, last_applied_at = $2, last_error = $3, applied_generation = $4
+		WHERE id = $5
+	`
+
+	result, err := r.db.ExecContext(ctx, query, status.Phase, status.LastAppliedAt, status.LastError, status.AppliedGeneration, id)
+	if err != nil {
+		return fmt.Errorf("failed to update schedule status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return fmt.Errorf("schedule not found: %w", domain.ErrNotFound)
+	}
+
+	return nil
+}
+
+// SetPause приостанавливает расписание или, при pause == nil, возобновляет
+// его. ScaledObject при этом меняется, поэтому generation увеличивается.
+func (r *ScheduleRepository) SetPause(ctx context.Context, id string, pause *domain.PauseState) (*domain.Schedule, error) {
+	query := `
+		UPDATE schedules
+		SET paused_at = $1, paused_replicas = $2, paused_by = $3, pause_reason = $4,
+			generation = generation + 1, status_phase = 'Pending', updated_at = CURRENT_TIMESTAMP
+		WHERE id = $5
+		RETURNING ` + scheduleColumns
+
+	var pausedAt *time.Time
+	if pause == nil {
+		pause = &domain.PauseState{}
+	} else {
+		pausedAt = &pause.PausedAt
+	}
+
+	updated, err := scanSchedule(r.db.QueryRowContext(ctx, query, pausedAt, pause.Replicas, pause.PausedBy, pause.Reason, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("schedule not found: %w", domain.ErrNotFound)
+		}
+		return nil, fmt.Errorf("failed to set schedule pause: %w", err)
+	}
+
+	return updated, nil
+}
@@ -7,9 +7,17 @@ import (
 )
 
 type ScheduleRepository interface {
-	Create(ctx context.Context, rules domain.ScheduleRules, application *domain.Application) (*domain.Schedule, error)
+	Create(ctx context.Context, schedule *domain.Schedule) (*domain.Schedule, error)
 	GetByID(ctx context.Context, id string) (*domain.Schedule, error)
 	List(ctx context.Context) ([]*domain.Schedule, error)
-	Update(ctx context.Context, id string, rules domain.ScheduleRules, application *domain.Application) (*domain.Schedule, error)
+	Update(ctx context.Context, schedule *domain.Schedule) (*domain.Schedule, error)
 	Delete(ctx context.Context, id string) error
+	UpdateStatus(ctx context.Context, id string, status domain.ScheduleStatus) error
+	SetPause(ctx context.Context, id string, pause *domain.PauseState) (*domain.Schedule, error)
+}
+
+type OverrideRepository interface {
+	Create(ctx context.Context, override *domain.Override) (*domain.Override, error)
+	ListBySchedule(ctx context.Context, scheduleID string) ([]domain.Override, error)
+	Delete(ctx context.Context, scheduleID, id string) error
 }
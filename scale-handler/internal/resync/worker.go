@@ -0,0 +1,108 @@
+package resync
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"scale-handler/internal/domain"
+	"scale-handler/internal/reconciler"
+	"scale-handler/internal/usecase"
+)
+
+// Worker периодически сверяет ресурсы кластера со всеми расписаниями и
+// исправляет ручные правки и удаления
+type Worker struct {
+	scheduleUC *usecase.ScheduleUseCase
+	reconciler reconciler.Reconciler
+	interval   time.Duration
+	logger     *slog.Logger
+}
+
+func NewWorker(scheduleUC *usecase.ScheduleUseCase, reconciler reconciler.Reconciler, interval time.Duration, logger *slog.Logger) *Worker {
+	return &Worker{
+		scheduleUC: scheduleUC,
+		reconciler: reconciler,
+		interval:   interval,
+		logger:     logger,
+	}
+}
+
+// Run выполняет сверку сразу и затем с заданным интервалом до отмены ctx
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("Resync worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := w.RunOnce(ctx); err != nil {
+			w.logger.Error("Resync failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			w.logger.Info("Resync worker stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce выполняет один проход сверки и возвращает отчёт о расхождениях.
+// Ошибка одного расписания не останавливает проход.
+func (w *Worker) RunOnce(ctx context.Context) (*domain.DriftReport, error) {
+	report := &domain.DriftReport{
+		StartedAt: time.Now(),
+		Failed:    map[string]error{},
+	}
+
+	schedules, err := w.scheduleUC.ListSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, schedule := range schedules {
+		if ctx.Err() != nil {
+			return report, ctx.Err()
+		}
+		report.Checked++
+
+		changes, err := w.reconciler.SyncResources(ctx, schedule)
+		report.Changes = append(report.Changes, changes...)
+		if err != nil {
+			report.Failed[schedule.ID] = err
+		}
+
+		// Статус обновляется, только если что-то изменилось
+		upToDate := schedule.Status.Phase == domain.PhaseApplied && schedule.Status.AppliedGeneration == schedule.Generation
+		if err != nil || len(changes) > 0 || !upToDate {
+			if err := w.scheduleUC.RecordApplyResult(ctx, schedule, err); err != nil {
+				w.logger.Error("Failed to save schedule status", "schedule_id", schedule.ID, "error", err)
+			}
+		}
+	}
+	report.Duration = time.Since(report.StartedAt)
+
+	w.logReport(report)
+	return report, nil
+}
+
+func (w *Worker) logReport(report *domain.DriftReport) {
+	for _, ch := range report.Changes {
+		w.logger.Warn("Drift corrected",
+			"schedule_id", ch.ScheduleID,
+			"kind", ch.Kind,
+			"namespace", ch.Namespace,
+			"name", ch.Name,
+			"action", ch.Action)
+	}
+	for id, err := range report.Failed {
+		w.logger.Error("Resync of schedule failed", "schedule_id", id, "error", err)
+	}
+	w.logger.Info("Resync finished",
+		"checked", report.Checked,
+		"changed", len(report.Changes),
+		"failed", len(report.Failed),
+		"duration", report.Duration)
+}
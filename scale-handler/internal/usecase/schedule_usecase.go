@@ -2,45 +2,430 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"slices"
+	"strings"
+	"time"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"scale-handler/internal/config"
 	"scale-handler/internal/domain"
 	"scale-handler/internal/repository"
 )
 
+const dateLayout = "2006-01-02"
+
 type ScheduleUseCase struct {
-	repo   repository.ScheduleRepository
-	logger *slog.Logger
+	repo              repository.ScheduleRepository
+	overrideRepo      repository.OverrideRepository
+	defaultNamespace  string
+	allowedNamespaces []string
+	defaultTimezone   string
+	logger            *slog.Logger
 }
 
-func NewScheduleUseCase(repo repository.ScheduleRepository, logger *slog.Logger) *ScheduleUseCase {
+func NewScheduleUseCase(repo repository.ScheduleRepository, overrideRepo repository.OverrideRepository, cfg config.K8sConfig, logger *slog.Logger) *ScheduleUseCase {
 	return &ScheduleUseCase{
-		repo:   repo,
-		logger: logger,
+		repo:              repo,
+		overrideRepo:      overrideRepo,
+		defaultNamespace:  cfg.DefaultNamespace,
+		allowedNamespaces: cfg.AllowedNamespaces,
+		defaultTimezone:   cfg.DefaultTimezone,
+		logger:            logger,
+	}
+}
+
+func (uc *ScheduleUseCase) CreateSchedule(ctx context.Context, schedule *domain.Schedule) (*domain.Schedule, error) {
+	uc.logger.Debug("Creating schedule", "rules", schedule.Rules)
+	if err := uc.prepareSchedule(schedule, nil); err != nil {
+		return nil, err
 	}
+	return uc.repo.Create(ctx, schedule)
 }
 
-func (uc *ScheduleUseCase) CreateSchedule(ctx context.Context, rules domain.ScheduleRules, application *domain.Application) (*domain.Schedule, error) {
-	uc.logger.Debug("Creating schedule", "rules", rules)
-	return uc.repo.Create(ctx, rules, application)
+// ValidateSchedule проверяет расписание и подставляет значения по умолчанию
+// так же, как при сохранении, но ничего не сохраняет. previous - сохранённая
+// версия при обновлении, nil при создании.
+func (uc *ScheduleUseCase) ValidateSchedule(schedule, previous *domain.Schedule) error {
+	return uc.prepareSchedule(schedule, previous)
 }
 
 func (uc *ScheduleUseCase) GetSchedule(ctx context.Context, id string) (*domain.Schedule, error) {
 	uc.logger.Debug("Getting schedule", "id", id)
-	return uc.repo.GetByID(ctx, id)
+	return uc.resolveNamespace(uc.repo.GetByID(ctx, id))
 }
 
 func (uc *ScheduleUseCase) ListSchedules(ctx context.Context) ([]*domain.Schedule, error) {
 	uc.logger.Debug("Listing schedules")
-	return uc.repo.List(ctx)
+	schedules, err := uc.repo.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range schedules {
+		uc.resolveNamespace(s, nil)
+	}
+	return schedules, nil
+}
+
+// resolveNamespace подставляет namespace по умолчанию расписаниям,
+// сохранённым до появления namespace
+func (uc *ScheduleUseCase) resolveNamespace(schedule *domain.Schedule, err error) (*domain.Schedule, error) {
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Namespace == "" {
+		schedule.Namespace = uc.defaultNamespace
+	}
+	return schedule, nil
 }
 
-func (uc *ScheduleUseCase) UpdateSchedule(ctx context.Context, id string, rules domain.ScheduleRules, application *domain.Application) (*domain.Schedule, error) {
-	uc.logger.Debug("Updating schedule", "id", id, "rules", rules)
-	return uc.repo.Update(ctx, id, rules, application)
+func (uc *ScheduleUseCase) UpdateSchedule(ctx context.Context, schedule *domain.Schedule) (*domain.Schedule, error) {
+	uc.logger.Debug("Updating schedule", "id", schedule.ID, "rules", schedule.Rules)
+	previous, err := uc.resolveNamespace(uc.repo.GetByID(ctx, schedule.ID))
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.prepareSchedule(schedule, previous); err != nil {
+		return nil, err
+	}
+	return uc.repo.Update(ctx, schedule)
 }
 
 func (uc *ScheduleUseCase) DeleteSchedule(ctx context.Context, id string) error {
 	uc.logger.Debug("Deleting schedule", "id", id)
 	return uc.repo.Delete(ctx, id)
 }
+
+// PauseSchedule приостанавливает расписание на pause.Replicas репликах.
+// Повторная пауза заменяет число реплик и причину.
+func (uc *ScheduleUseCase) PauseSchedule(ctx context.Context, id string, pause domain.PauseState) (*domain.Schedule, error) {
+	uc.logger.Debug("Pausing schedule", "id", id, "replicas", pause.Replicas, "paused_by", pause.PausedBy)
+	if pause.Replicas < 0 {
+		return nil, fmt.Errorf("paused replicas must not be negative: %w", domain.ErrInvalidArgument)
+	}
+	pause.PausedAt = time.Now()
+	return uc.resolveNamespace(uc.repo.SetPause(ctx, id, &pause))
+}
+
+// ResumeSchedule снимает паузу, и расписание снова масштабирует workload
+func (uc *ScheduleUseCase) ResumeSchedule(ctx context.Context, id string) (*domain.Schedule, error) {
+	uc.logger.Debug("Resuming schedule", "id", id)
+	schedule, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Pause == nil {
+		return nil, fmt.Errorf("schedule is not paused: %w", domain.ErrConflict)
+	}
+	return uc.resolveNamespace(uc.repo.SetPause(ctx, id, nil))
+}
+
+// CreateOverride проверяет и сохраняет переопределение. Начало по умолчанию -
+// текущий момент; границы выравниваются на минуты, как в cron. Переопределение
+// должно быть короче года: в cron нет года. HPA берёт максимум по триггерам,
+// поэтому переопределение - нижняя граница и не может быть ниже minReplicaCount.
+func (uc *ScheduleUseCase) CreateOverride(ctx context.Context, override domain.Override) (*domain.Override, error) {
+	uc.logger.Debug("Creating override", "schedule_id", override.ScheduleID, "replicas", override.Replicas)
+	schedule, err := uc.repo.GetByID(ctx, override.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if override.Start.IsZero() {
+		override.Start = now
+	}
+	override.Start = override.Start.Truncate(time.Minute)
+	if end := override.End.Truncate(time.Minute); end.Before(override.End) {
+		override.End = end.Add(time.Minute)
+	}
+
+	switch {
+	case override.Replicas < 0:
+		return nil, fmt.Errorf("override replicas must not be negative: %w", domain.ErrInvalidArgument)
+	case !override.End.After(override.Start):
+		return nil, fmt.Errorf("override end must be after start: %w", domain.ErrInvalidArgument)
+	case !override.End.After(now):
+		return nil, fmt.Errorf("override end must be in the future: %w", domain.ErrInvalidArgument)
+	case !override.End.Before(override.Start.AddDate(1, 0, 0)):
+		return nil, fmt.Errorf("override must be shorter than a year: %w", domain.ErrInvalidArgument)
+	}
+	minReplicas, maxReplicas := schedule.Rules.ReplicaBounds()
+	if override.Replicas < minReplicas || override.Replicas > maxReplicas {
+		return nil, fmt.Errorf("override replicas %d out of range [%d, %d]: %w", override.Replicas, minReplicas, maxReplicas, domain.ErrInvalidArgument)
+	}
+
+	return uc.overrideRepo.Create(ctx, &override)
+}
+
+// ListOverrides возвращает все переопределения расписания, включая истёкшие
+func (uc *ScheduleUseCase) ListOverrides(ctx context.Context, scheduleID string) ([]domain.Override, error) {
+	uc.logger.Debug("Listing overrides", "schedule_id", scheduleID)
+	if _, err := uc.repo.GetByID(ctx, scheduleID); err != nil {
+		return nil, err
+	}
+	return uc.overrideRepo.ListBySchedule(ctx, scheduleID)
+}
+
+func (uc *ScheduleUseCase) CancelOverride(ctx context.Context, scheduleID, id string) error {
+	uc.logger.Debug("Cancelling override", "schedule_id", scheduleID, "id", id)
+	return uc.overrideRepo.Delete(ctx, scheduleID, id)
+}
+
+// RecordApplyResult сохраняет результат применения расписания в кластере.
+// При ошибке время и generation последнего успешного применения сохраняются.
+func (uc *ScheduleUseCase) RecordApplyResult(ctx context.Context, schedule *domain.Schedule, applyErr error) error {
+	status := schedule.Status
+	if applyErr != nil {
+		status.Phase = domain.PhaseDegraded
+		status.LastError = applyErr.Error()
+	} else {
+		now := time.Now()
+		status.Phase = domain.PhaseApplied
+		status.LastAppliedAt = &now
+		status.LastError = ""
+		status.AppliedGeneration = schedule.Generation
+	}
+
+	if err := uc.repo.UpdateStatus(ctx, schedule.ID, status); err != nil {
+		return err
+	}
+	schedule.Status = status
+	return nil
+}
+
+// prepareSchedule проверяет даты, ресурсы, вид workload и цель, подставляет
+// namespace по умолчанию и проверяет allow-list. ScaledObject KEDA должен
+// лежать в namespace цели, поэтому namespace цели и расписания совпадают.
+// previous - сохранённая версия при обновлении, nil при создании.
+func (uc *ScheduleUseCase) prepareSchedule(schedule, previous *domain.Schedule) error {
+	var previousRules *domain.ScheduleRules
+	if previous != nil {
+		previousRules = &previous.Rules
+	}
+	if err := uc.validateDates(&schedule.Rules, previousRules); err != nil {
+		return err
+	}
+	if kind := schedule.Application.WorkloadKind(); kind != domain.KindDeployment && kind != domain.KindStatefulSet {
+		return fmt.Errorf("unsupported application kind %s: %w", kind, domain.ErrInvalidArgument)
+	}
+	if err := validateResources(schedule.Application); err != nil {
+		return err
+	}
+	if err := validateNetwork(schedule.Application); err != nil {
+		return err
+	}
+	if err := validateVolumes(schedule.Application); err != nil {
+		return err
+	}
+	if err := validateProbes(schedule.Application); err != nil {
+		return err
+	}
+	if target := schedule.Target; target != nil {
+		if schedule.Application != nil && len(schedule.Application.Containers) > 0 {
+			return fmt.Errorf("target and application are mutually exclusive: %w", domain.ErrInvalidArgument)
+		}
+		if target.Name == "" {
+			return fmt.Errorf("target name is required: %w", domain.ErrInvalidArgument)
+		}
+		switch {
+		case target.Namespace == "":
+		case schedule.Namespace == "":
+			schedule.Namespace = target.Namespace
+		case schedule.Namespace != target.Namespace:
+			return fmt.Errorf("target namespace %s differs from schedule namespace %s: %w", target.Namespace, schedule.Namespace, domain.ErrInvalidArgument)
+		}
+	}
+
+	if schedule.Namespace == "" {
+		schedule.Namespace = uc.defaultNamespace
+	}
+	if len(uc.allowedNamespaces) > 0 && !slices.Contains(uc.allowedNamespaces, schedule.Namespace) {
+		return fmt.Errorf("namespace %s is not allowed: %w", schedule.Namespace, domain.ErrInvalidArgument)
+	}
+	if schedule.Target != nil {
+		schedule.Target.Namespace = schedule.Namespace
+	}
+	return nil
+}
+
+// validateDates проверяет таймзону, даты окон и исключений. Прошедшая дата окна
+// отклоняется в таймзоне расписания, если её не было в сохранённой версии:
+// иначе неизменённое расписание нельзя было бы сохранить повторно.
+// Прошедшие окна в ScaledObject не попадают.
+func (uc *ScheduleUseCase) validateDates(rules, previous *domain.ScheduleRules) error {
+	timezone := rules.Timezone
+	if timezone == "" {
+		timezone = uc.defaultTimezone
+	}
+	if !config.IsIANATimezone(timezone) {
+		return fmt.Errorf("invalid timezone %s: %w", timezone, domain.ErrInvalidArgument)
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return fmt.Errorf("load timezone %s: %w", timezone, err)
+	}
+
+	today := time.Now().In(loc).Format(dateLayout)
+	for date := range rules.Dates {
+		if _, err := time.Parse(dateLayout, date); err != nil {
+			return fmt.Errorf("invalid date %s, expected YYYY-MM-DD: %w", date, domain.ErrInvalidArgument)
+		}
+		if date >= today {
+			continue
+		}
+		if previous != nil {
+			if _, stored := previous.Dates[date]; stored {
+				continue
+			}
+		}
+		return fmt.Errorf("date %s is in the past: %w", date, domain.ErrInvalidArgument)
+	}
+	for _, date := range rules.Exceptions {
+		if _, err := time.Parse(dateLayout, date); err != nil {
+			return fmt.Errorf("invalid exception date %s, expected YYYY-MM-DD: %w", date, domain.ErrInvalidArgument)
+		}
+	}
+	return nil
+}
+
+// validateResources проверяет quantity в requests и limits контейнеров:
+// при рендере они разбираются так же, через resource.ParseQuantity
+func validateResources(app *domain.Application) error {
+	if app == nil {
+		return nil
+	}
+	for _, c := range app.AllContainers() {
+		if c.Resources == nil {
+			continue
+		}
+		for kind, q := range map[string]*domain.ResourceQuantity{"requests": c.Resources.Requests, "limits": c.Resources.Limits} {
+			if q == nil {
+				continue
+			}
+			for name, value := range map[string]string{"cpu": q.CPU, "memory": q.Memory} {
+				if value == "" {
+					continue
+				}
+				if _, err := resource.ParseQuantity(value); err != nil {
+					return fmt.Errorf("container %s: invalid %s.%s %q: %w", c.Name, kind, name, value, domain.ErrInvalidArgument)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// validateNetwork проверяет, что Service есть что публиковать, а Ingress
+// есть куда направить
+func validateNetwork(app *domain.Application) error {
+	if app == nil {
+		return nil
+	}
+	if app.Ingress != nil && app.Service == nil {
+		return fmt.Errorf("ingress requires service: %w", domain.ErrInvalidArgument)
+	}
+	if app.Service != nil && len(app.Service.Ports) == 0 {
+		for _, c := range app.Containers {
+			if len(c.Ports) > 0 {
+				return nil
+			}
+		}
+		return fmt.Errorf("service has no ports to expose: %w", domain.ErrInvalidArgument)
+	}
+	return nil
+}
+
+// validateVolumes проверяет, что у каждого тома ровно один источник, а
+// монтирования ссылаются на объявленные тома
+func validateVolumes(app *domain.Application) error {
+	if app == nil {
+		return nil
+	}
+	volumes := make(map[string]bool, len(app.Volumes))
+	for _, v := range app.Volumes {
+		if v.Name == "" {
+			return fmt.Errorf("volume name is required: %w", domain.ErrInvalidArgument)
+		}
+		if volumes[v.Name] {
+			return fmt.Errorf("duplicate volume %s: %w", v.Name, domain.ErrInvalidArgument)
+		}
+		volumes[v.Name] = true
+
+		if countSet(v.EmptyDir != nil, v.ConfigMap != nil, v.Secret != nil, v.PersistentVolumeClaim != nil, v.Projected != nil) != 1 {
+			return fmt.Errorf("volume %s must have exactly one source: %w", v.Name, domain.ErrInvalidArgument)
+		}
+		if v.EmptyDir != nil && v.EmptyDir.SizeLimit != "" {
+			if _, err := resource.ParseQuantity(v.EmptyDir.SizeLimit); err != nil {
+				return fmt.Errorf("volume %s: invalid sizeLimit %s: %w", v.Name, v.EmptyDir.SizeLimit, domain.ErrInvalidArgument)
+			}
+		}
+		if v.Projected != nil {
+			for _, s := range v.Projected.Sources {
+				if countSet(s.ConfigMap != nil, s.Secret != nil, s.ServiceAccountToken != nil) != 1 {
+					return fmt.Errorf("volume %s: projected source must have exactly one of configMap, secret, serviceAccountToken: %w", v.Name, domain.ErrInvalidArgument)
+				}
+			}
+		}
+	}
+
+	for _, c := range app.AllContainers() {
+		for _, m := range c.VolumeMounts {
+			if !volumes[m.Name] {
+				return fmt.Errorf("container %s mounts unknown volume %s: %w", c.Name, m.Name, domain.ErrInvalidArgument)
+			}
+			if !strings.HasPrefix(m.MountPath, "/") {
+				return fmt.Errorf("container %s: mountPath must be absolute: %s: %w", c.Name, m.MountPath, domain.ErrInvalidArgument)
+			}
+		}
+	}
+	return nil
+}
+
+// validateProbes проверяет, что у каждой проверки ровно одно действие.
+// Kubernetes допускает successThreshold больше 1 только для readiness,
+// а у init-контейнеров проверок нет вовсе.
+func validateProbes(app *domain.Application) error {
+	if app == nil {
+		return nil
+	}
+	for _, c := range app.InitContainers {
+		if c.LivenessProbe != nil || c.ReadinessProbe != nil || c.StartupProbe != nil {
+			return fmt.Errorf("init container %s must not have probes: %w", c.Name, domain.ErrInvalidArgument)
+		}
+	}
+	for _, c := range app.Containers {
+		probes := []struct {
+			name  string
+			probe *domain.Probe
+		}{
+			{"livenessProbe", c.LivenessProbe},
+			{"readinessProbe", c.ReadinessProbe},
+			{"startupProbe", c.StartupProbe},
+		}
+		for _, p := range probes {
+			if p.probe == nil {
+				continue
+			}
+			if countSet(p.probe.HTTPGet != nil, p.probe.TCPSocket != nil, p.probe.Exec != nil, p.probe.GRPC != nil) != 1 {
+				return fmt.Errorf("container %s: %s must have exactly one handler: %w", c.Name, p.name, domain.ErrInvalidArgument)
+			}
+			if p.probe.SuccessThreshold > 1 && p.probe != c.ReadinessProbe {
+				return fmt.Errorf("container %s: %s successThreshold must be 1: %w", c.Name, p.name, domain.ErrInvalidArgument)
+			}
+		}
+	}
+	return nil
+}
+
+func countSet(flags ...bool) int {
+	n := 0
+	for _, f := range flags {
+		if f {
+			n++
+		}
+	}
+	return n
+}
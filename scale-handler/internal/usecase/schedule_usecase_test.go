@@ -0,0 +1,420 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"scale-handler/internal/domain"
+	"scale-handler/internal/repository"
+)
+
+func TestValidateDates(t *testing.T) {
+	uc := &ScheduleUseCase{defaultTimezone: "Europe/Moscow"}
+	loc, err := time.LoadLocation(uc.defaultTimezone)
+	if err != nil {
+		t.Skip(err)
+	}
+	today := time.Now().In(loc)
+	past := today.AddDate(0, 0, -2).Format(dateLayout)
+	future := today.AddDate(0, 0, 2).Format(dateLayout)
+	window := []domain.TimeRange{{From: "10:00", To: "12:00", Replicas: 1}}
+
+	tests := []struct {
+		name     string
+		rules    domain.ScheduleRules
+		previous *domain.ScheduleRules
+		wantErr  bool
+	}{
+		{
+			name:  "future date",
+			rules: domain.ScheduleRules{Dates: map[string][]domain.TimeRange{future: window}},
+		},
+		{
+			name:    "past date on create",
+			rules:   domain.ScheduleRules{Dates: map[string][]domain.TimeRange{past: window}},
+			wantErr: true,
+		},
+		{
+			name:     "stored past date on update",
+			rules:    domain.ScheduleRules{Dates: map[string][]domain.TimeRange{past: window}},
+			previous: &domain.ScheduleRules{Dates: map[string][]domain.TimeRange{past: window}},
+		},
+		{
+			name:     "new past date on update",
+			rules:    domain.ScheduleRules{Dates: map[string][]domain.TimeRange{past: window}},
+			previous: &domain.ScheduleRules{Dates: map[string][]domain.TimeRange{future: window}},
+			wantErr:  true,
+		},
+		{
+			name:    "invalid date",
+			rules:   domain.ScheduleRules{Dates: map[string][]domain.TimeRange{"2026-02-30": window}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid exception",
+			rules:   domain.ScheduleRules{Exceptions: []string{future, "2026-02-30"}},
+			wantErr: true,
+		},
+		{
+			name:  "past exception",
+			rules: domain.ScheduleRules{Exceptions: []string{past}},
+		},
+		{
+			name:  "schedule timezone",
+			rules: domain.ScheduleRules{Timezone: "Asia/Tokyo", Dates: map[string][]domain.TimeRange{future: window}},
+		},
+		{
+			name:    "invalid timezone",
+			rules:   domain.ScheduleRules{Timezone: "Mars/Olympus"},
+			wantErr: true,
+		},
+		{
+			name:    "local timezone",
+			rules:   domain.ScheduleRules{Timezone: "Local"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := uc.validateDates(&tt.rules, tt.previous)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateDates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("validateDates() error = %v, want ErrInvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestPrepareSchedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowed   []string
+		namespace string
+		target    *domain.TargetRef
+		app       *domain.Application
+		want      string
+		wantErr   bool
+	}{
+		{name: "default namespace", want: "default"},
+		{name: "explicit namespace", namespace: "team-a", want: "team-a"},
+		{name: "allowed namespace", allowed: []string{"team-a"}, namespace: "team-a", want: "team-a"},
+		{name: "namespace not allowed", allowed: []string{"team-a"}, namespace: "team-b", wantErr: true},
+		{name: "default namespace not allowed", allowed: []string{"team-a"}, wantErr: true},
+		{name: "namespace from target", target: &domain.TargetRef{Name: "web", Namespace: "team-a"}, want: "team-a"},
+		{name: "target in default namespace", target: &domain.TargetRef{Name: "web"}, want: "default"},
+		{
+			name:      "target namespace differs",
+			namespace: "team-a",
+			target:    &domain.TargetRef{Name: "web", Namespace: "team-b"},
+			wantErr:   true,
+		},
+		{name: "target without name", target: &domain.TargetRef{}, wantErr: true},
+		{name: "statefulset application", app: &domain.Application{Kind: domain.KindStatefulSet}, want: "default"},
+		{name: "unsupported application kind", app: &domain.Application{Kind: "DaemonSet"}, wantErr: true},
+		{
+			name:    "target with application",
+			target:  &domain.TargetRef{Name: "web"},
+			app:     &domain.Application{Containers: []domain.Container{{Name: "web", Image: "nginx"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &ScheduleUseCase{defaultNamespace: "default", allowedNamespaces: tt.allowed, defaultTimezone: "UTC"}
+			schedule := &domain.Schedule{Namespace: tt.namespace, Target: tt.target, Application: tt.app}
+			err := uc.prepareSchedule(schedule, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("prepareSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !errors.Is(err, domain.ErrInvalidArgument) {
+					t.Errorf("prepareSchedule() error = %v, want ErrInvalidArgument", err)
+				}
+				return
+			}
+			if schedule.Namespace != tt.want {
+				t.Errorf("namespace = %q, want %q", schedule.Namespace, tt.want)
+			}
+			if tt.target != nil && tt.target.Namespace != tt.want {
+				t.Errorf("target namespace = %q, want %q", tt.target.Namespace, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateResources(t *testing.T) {
+	withResources := func(r *domain.Resources) *domain.Application {
+		return &domain.Application{Containers: []domain.Container{{Name: "web", Image: "nginx", Resources: r}}}
+	}
+
+	tests := []struct {
+		name    string
+		app     *domain.Application
+		wantErr bool
+	}{
+		{name: "no application"},
+		{
+			name: "valid quantities",
+			app: withResources(&domain.Resources{
+				Requests: &domain.ResourceQuantity{CPU: "250m", Memory: "128Mi"},
+				Limits:   &domain.ResourceQuantity{CPU: "1", Memory: "1Gi"},
+			}),
+		},
+		{
+			name:    "invalid cpu request",
+			app:     withResources(&domain.Resources{Requests: &domain.ResourceQuantity{CPU: "half"}}),
+			wantErr: true,
+		},
+		{
+			name:    "invalid memory limit",
+			app:     withResources(&domain.Resources{Limits: &domain.ResourceQuantity{Memory: "1 GB"}}),
+			wantErr: true,
+		},
+		{
+			name: "invalid init container quantity",
+			app: &domain.Application{
+				InitContainers: []domain.Container{{Name: "migrate", Image: "app", Resources: &domain.Resources{Requests: &domain.ResourceQuantity{CPU: "half"}}}},
+				Containers:     []domain.Container{{Name: "web", Image: "nginx"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateResources(tt.app)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateResources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("validateResources() error = %v, want ErrInvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestValidateNetwork(t *testing.T) {
+	web := domain.Container{Name: "web", Image: "nginx", Ports: []domain.ContainerPort{{ContainerPort: 8080}}}
+	worker := domain.Container{Name: "worker", Image: "worker"}
+
+	tests := []struct {
+		name    string
+		app     *domain.Application
+		wantErr bool
+	}{
+		{name: "no application"},
+		{
+			name: "service from container ports",
+			app:  &domain.Application{Containers: []domain.Container{web}, Service: &domain.ServiceSpec{}},
+		},
+		{
+			name: "service with explicit ports",
+			app: &domain.Application{
+				Containers: []domain.Container{worker},
+				Service:    &domain.ServiceSpec{Ports: []domain.ServicePort{{Port: 80}}},
+			},
+		},
+		{
+			name:    "service without ports",
+			app:     &domain.Application{Containers: []domain.Container{worker}, Service: &domain.ServiceSpec{}},
+			wantErr: true,
+		},
+		{
+			name:    "ingress without service",
+			app:     &domain.Application{Containers: []domain.Container{web}, Ingress: &domain.IngressSpec{Host: "web.example.com"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateNetwork(tt.app)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateNetwork() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("validateNetwork() error = %v, want ErrInvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestValidateVolumes(t *testing.T) {
+	withVolumes := func(volumes []domain.Volume, mounts ...domain.VolumeMount) *domain.Application {
+		return &domain.Application{
+			Containers: []domain.Container{{Name: "web", Image: "nginx", VolumeMounts: mounts}},
+			Volumes:    volumes,
+		}
+	}
+	cache := domain.Volume{Name: "cache", EmptyDir: &domain.EmptyDirVolume{SizeLimit: "1Gi"}}
+
+	tests := []struct {
+		name    string
+		app     *domain.Application
+		wantErr bool
+	}{
+		{name: "no application"},
+		{
+			name: "mounted volumes",
+			app: withVolumes(
+				[]domain.Volume{cache, {Name: "data", PersistentVolumeClaim: &domain.PersistentVolumeClaim{ClaimName: "data"}}},
+				domain.VolumeMount{Name: "cache", MountPath: "/cache"},
+				domain.VolumeMount{Name: "data", MountPath: "/data"},
+			),
+		},
+		{
+			name:    "mount of unknown volume",
+			app:     withVolumes(nil, domain.VolumeMount{Name: "cache", MountPath: "/cache"}),
+			wantErr: true,
+		},
+		{
+			name:    "relative mount path",
+			app:     withVolumes([]domain.Volume{cache}, domain.VolumeMount{Name: "cache", MountPath: "cache"}),
+			wantErr: true,
+		},
+		{
+			name:    "duplicate volume",
+			app:     withVolumes([]domain.Volume{cache, cache}),
+			wantErr: true,
+		},
+		{
+			name:    "volume without source",
+			app:     withVolumes([]domain.Volume{{Name: "cache"}}),
+			wantErr: true,
+		},
+		{
+			name:    "invalid sizeLimit",
+			app:     withVolumes([]domain.Volume{{Name: "cache", EmptyDir: &domain.EmptyDirVolume{SizeLimit: "big"}}}),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateVolumes(tt.app)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateVolumes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("validateVolumes() error = %v, want ErrInvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestValidateProbes(t *testing.T) {
+	withProbes := func(liveness, readiness *domain.Probe) *domain.Application {
+		return &domain.Application{Containers: []domain.Container{{Name: "web", Image: "nginx", LivenessProbe: liveness, ReadinessProbe: readiness}}}
+	}
+	tcp := &domain.TCPSocketAction{Port: 8080}
+
+	tests := []struct {
+		name    string
+		app     *domain.Application
+		wantErr bool
+	}{
+		{name: "no application"},
+		{
+			name: "tcp liveness and readiness with successThreshold",
+			app:  withProbes(&domain.Probe{TCPSocket: tcp}, &domain.Probe{TCPSocket: tcp, SuccessThreshold: 3}),
+		},
+		{
+			name:    "no handler",
+			app:     withProbes(&domain.Probe{PeriodSeconds: 10}, nil),
+			wantErr: true,
+		},
+		{
+			name:    "two handlers",
+			app:     withProbes(&domain.Probe{TCPSocket: tcp, Exec: &domain.ExecAction{Command: []string{"true"}}}, nil),
+			wantErr: true,
+		},
+		{
+			name: "init container with probe",
+			app: &domain.Application{
+				InitContainers: []domain.Container{{Name: "migrate", Image: "app", LivenessProbe: &domain.Probe{TCPSocket: tcp}}},
+				Containers:     []domain.Container{{Name: "web", Image: "nginx"}},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "liveness successThreshold",
+			app:     withProbes(&domain.Probe{TCPSocket: tcp, SuccessThreshold: 2}, nil),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateProbes(tt.app)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("validateProbes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Errorf("validateProbes() error = %v, want ErrInvalidArgument", err)
+			}
+		})
+	}
+}
+
+// statusRepo сохраняет только статус; остальные методы репозитория в тесте
+// не вызываются
+type statusRepo struct {
+	repository.ScheduleRepository
+	saved *domain.ScheduleStatus
+}
+
+func (r *statusRepo) UpdateStatus(_ context.Context, _ string, status domain.ScheduleStatus) error {
+	r.saved = &status
+	return nil
+}
+
+func TestRecordApplyResult(t *testing.T) {
+	appliedAt := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	repo := &statusRepo{}
+	uc := &ScheduleUseCase{repo: repo}
+	schedule := &domain.Schedule{ID: "1", Generation: 3, Status: domain.ScheduleStatus{
+		Phase:             domain.PhaseApplied,
+		LastAppliedAt:     &appliedAt,
+		AppliedGeneration: 2,
+	}}
+
+	if err := uc.RecordApplyResult(context.Background(), schedule, errors.New("boom")); err != nil {
+		t.Fatalf("RecordApplyResult() error = %v", err)
+	}
+	// Ошибка не стирает последнее успешное применение
+	if got := *repo.saved; got.Phase != domain.PhaseDegraded || got.LastError != "boom" ||
+		got.AppliedGeneration != 2 || got.LastAppliedAt != &appliedAt {
+		t.Errorf("status after failure = %+v", got)
+	}
+
+	if err := uc.RecordApplyResult(context.Background(), schedule, nil); err != nil {
+		t.Fatalf("RecordApplyResult() error = %v", err)
+	}
+	if got := schedule.Status; got.Phase != domain.PhaseApplied || got.LastError != "" ||
+		got.AppliedGeneration != 3 || got.LastAppliedAt == nil {
+		t.Errorf("status after success = %+v", got)
+	}
+}
+
+func TestResolveNamespace(t *testing.T) {
+	uc := &ScheduleUseCase{defaultNamespace: "apps"}
+
+	// Строка, сохранённая до появления namespace, читается с пустым namespace
+	schedule, err := uc.resolveNamespace(&domain.Schedule{ID: "1"}, nil)
+	if err != nil || schedule.Namespace != "apps" {
+		t.Errorf("resolveNamespace() = %q, %v, want apps", schedule.Namespace, err)
+	}
+	schedule, _ = uc.resolveNamespace(&domain.Schedule{ID: "2", Namespace: "team-a"}, nil)
+	if schedule.Namespace != "team-a" {
+		t.Errorf("resolveNamespace() = %q, want team-a", schedule.Namespace)
+	}
+	if _, err := uc.resolveNamespace(nil, domain.ErrNotFound); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("resolveNamespace() error = %v, want ErrNotFound", err)
+	}
+}
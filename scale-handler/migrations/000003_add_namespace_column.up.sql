@@ -0,0 +1 @@
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS namespace TEXT NOT NULL DEFAULT 'default';
@@ -0,0 +1,6 @@
+ALTER TABLE schedules
+    DROP COLUMN IF EXISTS generation,
+    DROP COLUMN IF EXISTS status_phase,
+    DROP COLUMN IF EXISTS last_applied_at,
+    DROP COLUMN IF EXISTS last_error,
+    DROP COLUMN IF EXISTS applied_generation;
\ No newline at end of file
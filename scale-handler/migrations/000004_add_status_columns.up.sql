@@ -0,0 +1,6 @@
+ALTER TABLE schedules
+    ADD COLUMN IF NOT EXISTS generation BIGINT NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS status_phase TEXT NOT NULL DEFAULT 'Pending',
+    ADD COLUMN IF NOT EXISTS last_applied_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS last_error TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS applied_generation BIGINT NOT NULL DEFAULT 0;
\ No newline at end of file
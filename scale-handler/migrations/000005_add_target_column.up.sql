@@ -0,0 +1 @@
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS target JSONB;
\ No newline at end of file
@@ -0,0 +1 @@
+ALTER TABLE schedules ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}'::jsonb;
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE schedules
+    DROP COLUMN IF EXISTS paused_at,
+    DROP COLUMN IF EXISTS paused_replicas,
+    DROP COLUMN IF EXISTS paused_by,
+    DROP COLUMN IF EXISTS pause_reason;
\ No newline at end of file
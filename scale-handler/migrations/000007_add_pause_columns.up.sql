@@ -0,0 +1,5 @@
+ALTER TABLE schedules
+    ADD COLUMN IF NOT EXISTS paused_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS paused_replicas INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS paused_by TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS pause_reason TEXT NOT NULL DEFAULT '';
\ No newline at end of file
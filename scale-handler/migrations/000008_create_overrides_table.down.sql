@@ -0,0 +1 @@
+DROP TABLE IF EXISTS schedule_overrides;
\ No newline at end of file
@@ -0,0 +1,5 @@
+UPDATE schedules SET namespace = 'default' WHERE namespace IS NULL;
+
+ALTER TABLE schedules
+    ALTER COLUMN namespace SET DEFAULT 'default',
+    ALTER COLUMN namespace SET NOT NULL;
\ No newline at end of file
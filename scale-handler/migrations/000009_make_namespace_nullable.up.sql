@@ -0,0 +1,3 @@
+ALTER TABLE schedules
+    ALTER COLUMN namespace DROP NOT NULL,
+    ALTER COLUMN namespace DROP DEFAULT;
\ No newline at end of file
@@ -24,42 +24,77 @@ var File_service_proto protoreflect.FileDescriptor
 
 const file_service_proto_rawDesc = "" +
 	"\n" +
-	"\rservice.proto\x12\fscalehandler\x1a\x0fcontracts.proto2\xdf\x02\n" +
+	"\rservice.proto\x12\fscalehandler\x1a\x0fcontracts.proto2\x9c\a\n" +
 	"\x13ScaleHandlerService\x12C\n" +
 	"\x06Create\x12\x1b.scalehandler.CreateRequest\x1a\x1c.scalehandler.CreateResponse\x12=\n" +
 	"\x04List\x12\x19.scalehandler.ListRequest\x1a\x1a.scalehandler.ListResponse\x12:\n" +
 	"\x03Get\x12\x18.scalehandler.GetRequest\x1a\x19.scalehandler.GetResponse\x12C\n" +
 	"\x06Update\x12\x1b.scalehandler.UpdateRequest\x1a\x1c.scalehandler.UpdateResponse\x12C\n" +
-	"\x06Delete\x12\x1b.scalehandler.DeleteRequest\x1a\x1c.scalehandler.DeleteResponseB+Z)scale-handler/pkg/api/proto/scale-handlerb\x06proto3"
+	"\x06Delete\x12\x1b.scalehandler.DeleteRequest\x1a\x1c.scalehandler.DeleteResponse\x12C\n" +
+	"\x06Render\x12\x1b.scalehandler.RenderRequest\x1a\x1c.scalehandler.RenderResponse\x12@\n" +
+	"\x05Pause\x12\x1a.scalehandler.PauseRequest\x1a\x1b.scalehandler.PauseResponse\x12C\n" +
+	"\x06Resume\x12\x1b.scalehandler.ResumeRequest\x1a\x1c.scalehandler.ResumeResponse\x12[\n" +
+	"\x0eCreateOverride\x12#.scalehandler.CreateOverrideRequest\x1a$.scalehandler.CreateOverrideResponse\x12X\n" +
+	"\rListOverrides\x12\".scalehandler.ListOverridesRequest\x1a#.scalehandler.ListOverridesResponse\x12[\n" +
+	"\x0eCancelOverride\x12#.scalehandler.CancelOverrideRequest\x1a$.scalehandler.CancelOverrideResponse\x12[\n" +
+	"\x0eCollectGarbage\x12#.scalehandler.CollectGarbageRequest\x1a$.scalehandler.CollectGarbageResponseB+Z)scale-handler/pkg/api/proto/scale-handlerb\x06proto3"
 
 var file_service_proto_goTypes = []any{
-	(*CreateRequest)(nil),  // 0: scalehandler.CreateRequest
-	(*ListRequest)(nil),    // 1: scalehandler.ListRequest
-	(*GetRequest)(nil),     // 2: scalehandler.GetRequest
-	(*UpdateRequest)(nil),  // 3: scalehandler.UpdateRequest
-	(*DeleteRequest)(nil),  // 4: scalehandler.DeleteRequest
-	(*CreateResponse)(nil), // 5: scalehandler.CreateResponse
-	(*ListResponse)(nil),   // 6: scalehandler.ListResponse
-	(*GetResponse)(nil),    // 7: scalehandler.GetResponse
-	(*UpdateResponse)(nil), // 8: scalehandler.UpdateResponse
-	(*DeleteResponse)(nil), // 9: scalehandler.DeleteResponse
+	(*CreateRequest)(nil),          // 0: scalehandler.CreateRequest
+	(*ListRequest)(nil),            // 1: scalehandler.ListRequest
+	(*GetRequest)(nil),             // 2: scalehandler.GetRequest
+	(*UpdateRequest)(nil),          // 3: scalehandler.UpdateRequest
+	(*DeleteRequest)(nil),          // 4: scalehandler.DeleteRequest
+	(*RenderRequest)(nil),          // 5: scalehandler.RenderRequest
+	(*PauseRequest)(nil),           // 6: scalehandler.PauseRequest
+	(*ResumeRequest)(nil),          // 7: scalehandler.ResumeRequest
+	(*CreateOverrideRequest)(nil),  // 8: scalehandler.CreateOverrideRequest
+	(*ListOverridesRequest)(nil),   // 9: scalehandler.ListOverridesRequest
+	(*CancelOverrideRequest)(nil),  // 10: scalehandler.CancelOverrideRequest
+	(*CollectGarbageRequest)(nil),  // 11: scalehandler.CollectGarbageRequest
+	(*CreateResponse)(nil),         // 12: scalehandler.CreateResponse
+	(*ListResponse)(nil),           // 13: scalehandler.ListResponse
+	(*GetResponse)(nil),            // 14: scalehandler.GetResponse
+	(*UpdateResponse)(nil),         // 15: scalehandler.UpdateResponse
+	(*DeleteResponse)(nil),         // 16: scalehandler.DeleteResponse
+	(*RenderResponse)(nil),         // 17: scalehandler.RenderResponse
+	(*PauseResponse)(nil),          // 18: scalehandler.PauseResponse
+	(*ResumeResponse)(nil),         // 19: scalehandler.ResumeResponse
+	(*CreateOverrideResponse)(nil), // 20: scalehandler.CreateOverrideResponse
+	(*ListOverridesResponse)(nil),  // 21: scalehandler.ListOverridesResponse
+	(*CancelOverrideResponse)(nil), // 22: scalehandler.CancelOverrideResponse
+	(*CollectGarbageResponse)(nil), // 23: scalehandler.CollectGarbageResponse
 }
 var file_service_proto_depIdxs = []int32{
-	0, // 0: scalehandler.ScaleHandlerService.Create:input_type -> scalehandler.CreateRequest
-	1, // 1: scalehandler.ScaleHandlerService.List:input_type -> scalehandler.ListRequest
-	2, // 2: scalehandler.ScaleHandlerService.Get:input_type -> scalehandler.GetRequest
-	3, // 3: scalehandler.ScaleHandlerService.Update:input_type -> scalehandler.UpdateRequest
-	4, // 4: scalehandler.ScaleHandlerService.Delete:input_type -> scalehandler.DeleteRequest
-	5, // 5: scalehandler.ScaleHandlerService.Create:output_type -> scalehandler.CreateResponse
-	6, // 6: scalehandler.ScaleHandlerService.List:output_type -> scalehandler.ListResponse
-	7, // 7: scalehandler.ScaleHandlerService.Get:output_type -> scalehandler.GetResponse
-	8, // 8: scalehandler.ScaleHandlerService.Update:output_type -> scalehandler.UpdateResponse
-	9, // 9: scalehandler.ScaleHandlerService.Delete:output_type -> scalehandler.DeleteResponse
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0,  // 0: scalehandler.ScaleHandlerService.Create:input_type -> scalehandler.CreateRequest
+	1,  // 1: scalehandler.ScaleHandlerService.List:input_type -> scalehandler.ListRequest
+	2,  // 2: scalehandler.ScaleHandlerService.Get:input_type -> scalehandler.GetRequest
+	3,  // 3: scalehandler.ScaleHandlerService.Update:input_type -> scalehandler.UpdateRequest
+	4,  // 4: scalehandler.ScaleHandlerService.Delete:input_type -> scalehandler.DeleteRequest
+	5,  // 5: scalehandler.ScaleHandlerService.Render:input_type -> scalehandler.RenderRequest
+	6,  // 6: scalehandler.ScaleHandlerService.Pause:input_type -> scalehandler.PauseRequest
+	7,  // 7: scalehandler.ScaleHandlerService.Resume:input_type -> scalehandler.ResumeRequest
+	8,  // 8: scalehandler.ScaleHandlerService.CreateOverride:input_type -> scalehandler.CreateOverrideRequest
+	9,  // 9: scalehandler.ScaleHandlerService.ListOverrides:input_type -> scalehandler.ListOverridesRequest
+	10, // 10: scalehandler.ScaleHandlerService.CancelOverride:input_type -> scalehandler.CancelOverrideRequest
+	11, // 11: scalehandler.ScaleHandlerService.CollectGarbage:input_type -> scalehandler.CollectGarbageRequest
+	12, // 12: scalehandler.ScaleHandlerService.Create:output_type -> scalehandler.CreateResponse
+	13, // 13: scalehandler.ScaleHandlerService.List:output_type -> scalehandler.ListResponse
+	14, // 14: scalehandler.ScaleHandlerService.Get:output_type -> scalehandler.GetResponse
+	15, // 15: scalehandler.ScaleHandlerService.Update:output_type -> scalehandler.UpdateResponse
+	16, // 16: scalehandler.ScaleHandlerService.Delete:output_type -> scalehandler.DeleteResponse
+	17, // 17: scalehandler.ScaleHandlerService.Render:output_type -> scalehandler.RenderResponse
+	18, // 18: scalehandler.ScaleHandlerService.Pause:output_type -> scalehandler.PauseResponse
+	19, // 19: scalehandler.ScaleHandlerService.Resume:output_type -> scalehandler.ResumeResponse
+	20, // 20: scalehandler.ScaleHandlerService.CreateOverride:output_type -> scalehandler.CreateOverrideResponse
+	21, // 21: scalehandler.ScaleHandlerService.ListOverrides:output_type -> scalehandler.ListOverridesResponse
+	22, // 22: scalehandler.ScaleHandlerService.CancelOverride:output_type -> scalehandler.CancelOverrideResponse
+	23, // 23: scalehandler.ScaleHandlerService.CollectGarbage:output_type -> scalehandler.CollectGarbageResponse
+	12, // [12:24] is the sub-list for method output_type
+	0,  // [0:12] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_service_proto_init() }